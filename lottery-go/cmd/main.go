@@ -1,19 +1,39 @@
 package main
 
-import "lottery-go/internal/config"
+import (
+	"os"
+
+	"lottery-go/internal/base/logx"
+	"lottery-go/internal/config"
+)
 
 func main() {
+	// 启动失败（如自检不通过）或停止出错时以非0状态退出，由编排系统感知
+	if err := run(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// run 运行应用直到收到停止信号，返回前释放资源并刷新日志
+func run() error {
 	// 加载配置文件
 	err := config.LoadAll()
 	if err != nil {
 		panic(err)
 	}
+	defer logx.Close()
 
 	// 初始化项目
-	app, err := initApp()
+	app, cleanup, err := initApp()
 	if err != nil {
 		panic(err)
 	}
+	// 应用停止后关闭RPC连接、存储等资源
+	defer cleanup()
 
-	app.Run()
+	if err = app.Run(); err != nil {
+		logx.ErrorF("app stopped with error. %v", err)
+		return err
+	}
+	return nil
 }
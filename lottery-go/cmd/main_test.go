@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// 子进程中运行 main 的环境变量
+const runMainEnv = "LOTTERY_TEST_RUN_MAIN"
+
+// 链ID与RPC节点不一致，启动自检不通过
+const selfTestFailConfig = `
+log:
+  default:
+    level: info
+    filePath: logs/default.log
+contracts:
+  txJournal:
+    type: memory
+  daily-lottery:
+    rpcUrl: %s
+    chainId: 11155111
+    address: 0x00000000000000000000000000000000000000aa
+    privateKey: 4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318
+job:
+  recordStore:
+    type: memory
+server:
+  selfTest: true
+  http:
+    addr: 127.0.0.1:0
+`
+
+func TestMain_ExitCodeWhenSelfTestFails(t *testing.T) {
+	if os.Getenv(runMainEnv) == "1" {
+		main()
+		return
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Method != "eth_chainId" {
+			http.Error(w, "unavailable", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": "0x1"})
+	}))
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "configs"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	config := []byte(fmt.Sprintf(selfTestFailConfig, server.URL))
+	if err := os.WriteFile(filepath.Join(dir, "configs", "application-dev.yaml"), config, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, os.Args[0], "-test.run=^TestMain_ExitCodeWhenSelfTestFails$")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), runMainEnv+"=1")
+	output, err := cmd.CombinedOutput()
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+		t.Fatalf("expected exit code 1, got %v\n%s", err, output)
+	}
+}
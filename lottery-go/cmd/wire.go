@@ -8,11 +8,12 @@ import (
 	"lottery-go/internal/application"
 	"lottery-go/internal/contract"
 	"lottery-go/internal/job"
+	"lottery-go/internal/pkg/alert"
 	"lottery-go/internal/server"
 )
 
-func initApp() (*server.App, error) {
-	wire.Build(contract.ProviderSet, application.ProviderSet, job.ProviderSet, server.ProviderSet)
+func initApp() (*server.App, func(), error) {
+	wire.Build(alert.ProviderSet, contract.ProviderSet, application.ProviderSet, job.ProviderSet, server.ProviderSet)
 
-	return &server.App{}, nil
+	return &server.App{}, nil, nil
 }
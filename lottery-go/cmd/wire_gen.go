@@ -8,22 +8,69 @@ package main
 
 import (
 	"lottery-go/internal/application"
+	"lottery-go/internal/base/lifecycle"
 	"lottery-go/internal/contract"
 	"lottery-go/internal/job"
+	"lottery-go/internal/pkg/alert"
 	"lottery-go/internal/server"
 )
 
 // Injectors from wire.go:
 
-func initApp() (*server.App, error) {
-	dailyLotteryContract := contract.NewDailyLotteryContract()
+func initApp() (*server.App, func(), error) {
+	lifecycleLifecycle, cleanup := lifecycle.New()
+	client, cleanup2, err := contract.NewEthClient(lifecycleLifecycle)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	signer, cleanup3, err := contract.NewSigner()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	dailyLotteryContract := contract.NewDailyLotteryContract(client, signer)
 	dailyLotteryApplication := application.NewDailyLotteryApplication(dailyLotteryContract)
-	drawLotteryJob := job.NewDrawLotteryJob(dailyLotteryApplication)
-	registryJobs := job.NewRegistryJobs(drawLotteryJob)
-	cron, err := server.NewJob(registryJobs)
+	recordStore, cleanup4, err := job.NewRecordStore()
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	manager, err := alert.NewAlertManager()
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	drawLotteryJob := job.NewDrawLotteryJob(lifecycleLifecycle, dailyLotteryApplication, recordStore, manager)
+	watchDrawingJob := job.NewWatchDrawingJob(lifecycleLifecycle, drawLotteryJob)
+	walletBalanceJob := job.NewWalletBalanceJob(lifecycleLifecycle, dailyLotteryApplication)
+	scratchCardContract := contract.NewScratchCardContract(client, signer)
+	scratchCardApplication := application.NewScratchCardApplication(scratchCardContract)
+	scratchCardMonitorJob := job.NewScratchCardMonitorJob(lifecycleLifecycle, scratchCardApplication, manager)
+	registryJobs := job.NewRegistryJobs(drawLotteryJob, watchDrawingJob, walletBalanceJob, scratchCardMonitorJob)
+	jobSwitch := job.NewSwitch()
+	cron, err := server.NewJob(registryJobs, jobSwitch)
 	if err != nil {
-		return nil, err
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
 	}
-	app := server.NewApp(cron)
-	return app, nil
+	adminHandler := server.NewAdminHandler(dailyLotteryApplication, drawLotteryJob, jobSwitch)
+	healthHandler := server.NewHealthHandler(lifecycleLifecycle, cron, dailyLotteryApplication, manager)
+	httpServer := server.NewHttpServer(lifecycleLifecycle, adminHandler, healthHandler)
+	app := server.NewApp(lifecycleLifecycle, cron, httpServer)
+	return app, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
 }
@@ -1,40 +1,150 @@
 package application
 
 import (
+	"context"
+	"errors"
+	"lottery-go/internal/base/errorx"
 	"lottery-go/internal/contract"
+	"lottery-go/internal/pkg/eth"
+	"lottery-go/internal/pkg/health"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type DailyLotteryApplication struct {
 	dailyLotteryContract *contract.DailyLotteryContract
 }
 
+// DrawResult 开奖结果
+type DrawResult struct {
+	IsDrawn bool   // 是否已开奖
+	Drawing bool   // 开奖交易已确认，等待VRF随机数回调
+	TxHash  string // 本次发送的开奖交易哈希，未发送交易时为空
+	NotYet  string // 模拟执行预判会revert的原因（如未到开奖时间），未发送交易，稍后重试
+
+	NeedsIntervention bool // 重试无法解决的合约错误（如账户不是owner），需要立即人工处理
+}
+
+// isNotYet 可预判的合约错误，说明暂时不能开奖，而不是开奖失败
+func isNotYet(err error) bool {
+	var (
+		intervalErr *contract.MinDrawIntervalNotMetError // 未到开奖时间
+		noNumbers   *contract.NoNumbersToDrawError       // 本期还没有人购买
+		drawingErr  *contract.DrawingInProgressError     // 已在开奖中，等待随机数回调
+	)
+	return errors.As(err, &intervalErr) || errors.As(err, &noNumbers) || errors.As(err, &drawingErr)
+}
+
+// needsIntervention 账户权限、合约配置类错误，重试无效
+func needsIntervention(err error) bool {
+	var (
+		unauthorized *contract.OwnableUnauthorizedAccountError // 发送交易的账户不是owner
+		wrongNumber  *contract.WrongLotteryNumberError         // 期号与合约不一致
+		vrfErr       *contract.VRFRequestAlreadyRequestedError // VRF provider 有未完成的请求
+	)
+	return errors.As(err, &unauthorized) || errors.As(err, &wrongNumber) || errors.As(err, &vrfErr)
+}
+
 func NewDailyLotteryApplication(dailyLotteryContract *contract.DailyLotteryContract) *DailyLotteryApplication {
 	return &DailyLotteryApplication{dailyLotteryContract: dailyLotteryContract}
 }
 
-func (app *DailyLotteryApplication) Draw(lotteryNumber uint64) (bool, error) {
+func (app *DailyLotteryApplication) Draw(ctx context.Context, lotteryNumber uint64) (*DrawResult, error) {
 	// 检查合约状态，如果已经完成，则立即返回
-	state, err := app.dailyLotteryContract.DrawState(lotteryNumber)
+	state, err := app.dailyLotteryContract.DrawState(ctx, lotteryNumber)
 	if err != nil {
-		return false, err
+		return &DrawResult{}, err
 	}
 
 	// 如果已开奖，更新状态
-	// 如果正在开奖，不做处理，直接返回false
+	// 如果正在开奖，不发送交易，返回 Drawing 由调用方跟踪回调是否超时
 	// 如果还没开奖，触发合约开奖函数
-	isDraw := false
+	result := &DrawResult{}
 	if state == contract.Drawn {
-		isDraw = true
+		result.IsDrawn = true
+	} else if state == contract.Drawing {
+		result.Drawing = true
 	} else if state == contract.NotDrawn {
-		err = app.dailyLotteryContract.Draw(lotteryNumber)
-		if err == nil {
-			isDraw = true
+		var tx *eth.TxResult
+		tx, err = app.dailyLotteryContract.Draw(ctx, lotteryNumber)
+		if tx != nil {
+			// 交易已广播，等待确认失败（如应用停止）时也返回交易哈希
+			result.TxHash = tx.TxHash.Hex()
+		}
+
+		if isNotYet(err) {
+			result.NotYet, err = err.Error(), nil
+		} else if needsIntervention(err) {
+			result.NeedsIntervention = true
+		} else if err == nil {
+			// 交易回滚、被丢弃或区块被回滚，下次执行时重新检查状态
+			if tx.Outcome != eth.Confirmed {
+				err = errorx.New("draw transaction not confirmed", "outcome", tx.Outcome, "txHash", result.TxHash)
+			} else {
+				// 开奖交易只发起VRF请求，随机数回调后才完成开奖
+				result.IsDrawn = app.drawnAfterTx(ctx, lotteryNumber)
+				result.Drawing = !result.IsDrawn
+			}
 		}
 	}
 
-	return isDraw, err
+	return result, err
+}
+
+// drawnAfterTx 开奖交易确认后重新读取开奖状态，VRF在同一交易内回调时已开奖；
+// 读取失败时按开奖中处理，由后续检查确认
+func (app *DailyLotteryApplication) drawnAfterTx(ctx context.Context, lotteryNumber uint64) bool {
+	state, err := app.dailyLotteryContract.DrawState(ctx, lotteryNumber)
+	return err == nil && state == contract.Drawn
+}
+
+func (app *DailyLotteryApplication) CurrentLotteryNumber(ctx context.Context) (uint64, error) {
+	return app.dailyLotteryContract.LotteryNumber(ctx)
+}
+
+// DrawState 获取指定期号的开奖状态
+func (app *DailyLotteryApplication) DrawState(ctx context.Context, lotteryNumber uint64) (contract.DrawState, error) {
+	return app.dailyLotteryContract.DrawState(ctx, lotteryNumber)
 }
 
-func (app *DailyLotteryApplication) CurrentLotteryNumber() (uint64, error) {
-	return app.dailyLotteryContract.LotteryNumber()
+// OperatorBalance 发送开奖交易账户的地址和余额（wei）
+func (app *DailyLotteryApplication) OperatorBalance(ctx context.Context) (common.Address, *big.Int, error) {
+	return app.dailyLotteryContract.OperatorBalance(ctx)
+}
+
+// ReadinessChecks 开奖依赖的链上环境检查
+func (app *DailyLotteryApplication) ReadinessChecks() []health.Check {
+	return app.dailyLotteryContract.ReadinessChecks()
+}
+
+// CancelPending 取消发送开奖交易账户的未确认交易，返回取消交易的哈希
+func (app *DailyLotteryApplication) CancelPending(ctx context.Context, nonce *uint64) (string, error) {
+	return app.dailyLotteryContract.CancelPending(ctx, nonce)
+}
+
+// VRFStatus 开奖中时VRF请求的链上状态，用于诊断随机数回调未到达的原因
+func (app *DailyLotteryApplication) VRFStatus(ctx context.Context) (*contract.VRFStatus, error) {
+	return app.dailyLotteryContract.VRFStatus(ctx)
+}
+
+// ReRequestRandomness 开奖中时重新请求随机数，返回交易哈希；
+// provider 仍有未完成的请求时合约不允许重新请求，返回 *contract.VRFRequestAlreadyRequestedError
+func (app *DailyLotteryApplication) ReRequestRandomness(ctx context.Context, lotteryNumber uint64) (string, error) {
+	state, err := app.dailyLotteryContract.DrawState(ctx, lotteryNumber)
+	if err != nil {
+		return "", err
+	}
+	if state != contract.Drawing {
+		return "", errorx.New("lottery is not drawing", "lotteryNumber", lotteryNumber, "state", state)
+	}
+
+	tx, err := app.dailyLotteryContract.ReRequestRandomness(ctx)
+	if err != nil {
+		return "", err
+	}
+	if tx.Outcome != eth.Confirmed {
+		return tx.TxHash.Hex(), errorx.New("request transaction not confirmed", "outcome", tx.Outcome, "txHash", tx.TxHash.Hex())
+	}
+	return tx.TxHash.Hex(), nil
 }
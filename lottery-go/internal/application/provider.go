@@ -2,4 +2,4 @@ package application
 
 import "github.com/google/wire"
 
-var ProviderSet = wire.NewSet(NewDailyLotteryApplication)
+var ProviderSet = wire.NewSet(NewDailyLotteryApplication, NewScratchCardApplication)
@@ -0,0 +1,158 @@
+package application
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/base/logx"
+	"lottery-go/internal/contract"
+)
+
+// ScratchCardApplication 跟踪刮刮卡的购买事件，发现超时仍没有开奖结果（随机数回调丢失）的购买。
+// 未开奖的购买只保存在内存中，进程重启后从 lookbackBlocks 之前的区块重新扫描。
+// 开奖事件不含购买的标识，只能按用户与最早的未开奖购买配对，因此启动时额外扫描起始区块之前的 lookbackBlocks 个区块，
+// 这些购买只用于配对，避免其开奖结果与扫描范围内的购买配对而掩盖超时的购买。
+type ScratchCardApplication struct {
+	scratchCardContract *contract.ScratchCardContract
+	resultTimeout       time.Duration // 购买后等待开奖结果的最长时间
+
+	mu         sync.Mutex
+	nextBlock  uint64                                   // 下次扫描的起始区块，为0时尚未扫描
+	startBlock uint64                                   // 检查超时的起始区块，之前的购买只用于配对开奖结果
+	pending    map[common.Address][]*ScratchCardRequest // 每个用户尚未开奖的购买，按购买顺序
+}
+
+// ScratchCardRequest 尚未开奖的刮刮卡购买
+type ScratchCardRequest struct {
+	User        common.Address
+	Time        time.Time // 购买所在区块的时间
+	Value       *big.Int  // 支付的金额（wei）
+	BlockNumber uint64
+	TxHash      common.Hash
+}
+
+// ResultCheck 一次开奖结果检查的结果
+type ResultCheck struct {
+	FromBlock uint64
+	ToBlock   uint64
+	Pending   int                   // 尚未开奖的购买数，含超时的购买
+	Stuck     []*ScratchCardRequest // 超过等待时间仍没有开奖结果的购买，按购买时间排序
+}
+
+func NewScratchCardApplication(scratchCardContract *contract.ScratchCardContract) *ScratchCardApplication {
+	return &ScratchCardApplication{
+		scratchCardContract: scratchCardContract,
+		resultTimeout:       scratchCardContract.ResultTimeout(),
+		pending:             make(map[common.Address][]*ScratchCardRequest),
+	}
+}
+
+// Enabled 是否配置了刮刮卡合约
+func (app *ScratchCardApplication) Enabled() bool {
+	return app.scratchCardContract.Enabled()
+}
+
+// CheckResults 扫描上次检查之后已达到确认数的新区块，按用户将开奖结果与最早的未开奖购买配对，
+// 返回购买时间早于 now - resultTimeout 仍未开奖的购买。超过 2*lookbackBlocks 个区块仍未开奖的购买已报警过，不再跟踪
+func (app *ScratchCardApplication) CheckResults(ctx context.Context, now time.Time) (*ResultCheck, error) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	// 只扫描达到确认数的区块，扫描过的区块不再重新扫描，回滚会导致开奖事件丢失或购买重复计数
+	latest, err := app.scratchCardContract.ConfirmedBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lookback := app.scratchCardContract.LookbackBlocks()
+	from, scanFrom := app.nextBlock, app.nextBlock
+	if from == 0 {
+		from = latest - min(lookback, latest)
+		scanFrom = from - min(lookback, from)
+		app.startBlock = from
+	}
+	if from <= latest {
+		events, err := app.scratchCardContract.Events(ctx, scanFrom, latest)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			app.apply(event)
+		}
+		app.nextBlock = latest + 1
+	}
+	app.evict(latest - min(2*lookback, latest))
+
+	check := app.stuck(now)
+	check.FromBlock, check.ToBlock = from, latest
+	return check, nil
+}
+
+// stuck 统计未开奖的购买，以及购买时间早于 now - resultTimeout 的购买
+func (app *ScratchCardApplication) stuck(now time.Time) *ResultCheck {
+	check := &ResultCheck{}
+	deadline := now.Add(-app.resultTimeout)
+	for _, requests := range app.pending {
+		for _, request := range requests {
+			if request.BlockNumber < app.startBlock {
+				continue
+			}
+			check.Pending++
+			if request.Time.Before(deadline) {
+				check.Stuck = append(check.Stuck, request)
+			}
+		}
+	}
+	slices.SortFunc(check.Stuck, func(a, b *ScratchCardRequest) int { return a.Time.Compare(b.Time) })
+	return check
+}
+
+// evict 删除 horizon 之前的购买，避免一直没有开奖结果的购买使内存无限增长
+func (app *ScratchCardApplication) evict(horizon uint64) {
+	for user, requests := range app.pending {
+		i := 0
+		for i < len(requests) && requests[i].BlockNumber < horizon {
+			i++
+		}
+		switch {
+		case i == len(requests):
+			delete(app.pending, user)
+		case i > 0:
+			app.pending[user] = requests[i:]
+		}
+	}
+}
+
+// apply 购买事件加入用户的未开奖队列，开奖事件移除该用户最早的购买
+func (app *ScratchCardApplication) apply(event *contract.ScratchCardEvent) {
+	requests := app.pending[event.User]
+	if event.Result == nil {
+		// 已跟踪的购买（如回滚后重新打包）不重复计数
+		if slices.ContainsFunc(requests, func(request *ScratchCardRequest) bool { return request.TxHash == event.TxHash }) {
+			return
+		}
+		app.pending[event.User] = append(requests, &ScratchCardRequest{User: event.User, Time: event.Time,
+			Value: event.Value, BlockNumber: event.BlockNumber, TxHash: event.TxHash})
+		return
+	}
+
+	// 购买发生在扫描范围之前：开奖结果早于该用户最早的未开奖购买，不能与之配对，否则会掩盖超时的购买
+	if len(requests) == 0 || event.BlockNumber < requests[0].BlockNumber {
+		return
+	}
+
+	request := requests[0]
+	if wait := event.Time.Sub(request.Time); wait > app.resultTimeout {
+		logx.Warn("scratch card result arrived late.", "user", event.User.Hex(), "txHash", request.TxHash.Hex(),
+			"wait", wait, "prize", event.Result.Prize)
+	}
+	if len(requests) == 1 {
+		delete(app.pending, event.User)
+	} else {
+		app.pending[event.User] = requests[1:]
+	}
+}
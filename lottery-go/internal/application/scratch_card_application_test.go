@@ -0,0 +1,95 @@
+package application
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/contract"
+)
+
+func TestScratchCardApplication_Stuck(t *testing.T) {
+	app := &ScratchCardApplication{resultTimeout: 10 * time.Minute, pending: make(map[common.Address][]*ScratchCardRequest)}
+	now := time.Unix(1760000000, 0)
+	alice, bob := common.HexToAddress("0xa1"), common.HexToAddress("0xb0")
+
+	purchase := func(user common.Address, ago time.Duration, block uint64) *contract.ScratchCardEvent {
+		return &contract.ScratchCardEvent{User: user, Time: now.Add(-ago), BlockNumber: block,
+			TxHash: common.BigToHash(big.NewInt(int64(block))), Value: big.NewInt(1e15)}
+	}
+	result := func(user common.Address, ago time.Duration, block uint64) *contract.ScratchCardEvent {
+		return &contract.ScratchCardEvent{User: user, Time: now.Add(-ago), BlockNumber: block,
+			Result: &contract.ScratchCardResult{Prize: contract.NoPrize}}
+	}
+
+	for _, event := range []*contract.ScratchCardEvent{
+		result(bob, 40*time.Minute, 1), // 购买在扫描范围之前
+		purchase(alice, 30*time.Minute, 2),
+		purchase(alice, 20*time.Minute, 3),
+		purchase(alice, 20*time.Minute, 3), // 同一笔购买不重复计数
+		purchase(bob, 15*time.Minute, 4),
+		result(bob, 16*time.Minute, 3),   // 早于 bob 最早的未开奖购买，对应扫描范围之前的购买
+		result(alice, 19*time.Minute, 5), // 开奖结果对应 alice 最早的购买
+		purchase(bob, time.Minute, 6),
+	} {
+		app.apply(event)
+	}
+
+	check := app.stuck(now)
+	if check.Pending != 3 {
+		t.Fatalf("expected 3 pending, got %d", check.Pending)
+	}
+	if len(check.Stuck) != 2 || check.Stuck[0].BlockNumber != 3 || check.Stuck[1].BlockNumber != 4 {
+		t.Fatalf("unexpected stuck requests %+v", check.Stuck)
+	}
+
+	// 迟到的开奖结果
+	app.apply(result(alice, 0, 7))
+	app.apply(result(bob, 0, 7))
+	if check = app.stuck(now); check.Pending != 1 || len(check.Stuck) != 0 {
+		t.Fatalf("expected no stuck requests, got %d of %d", len(check.Stuck), check.Pending)
+	}
+}
+
+func TestScratchCardApplication_ScanStartsBeforeResult(t *testing.T) {
+	// 检查超时从区块10开始，之前的区块只用于配对开奖结果
+	app := &ScratchCardApplication{resultTimeout: 10 * time.Minute, startBlock: 10,
+		pending: make(map[common.Address][]*ScratchCardRequest)}
+	now := time.Unix(1760000000, 0)
+	alice := common.HexToAddress("0xa1")
+
+	for _, event := range []*contract.ScratchCardEvent{
+		{User: alice, Time: now.Add(-40 * time.Minute), BlockNumber: 8, TxHash: common.HexToHash("0x08")},
+		{User: alice, Time: now.Add(-30 * time.Minute), BlockNumber: 11, TxHash: common.HexToHash("0x11")},
+		// 区块8的购买的开奖结果在扫描范围内，不能与区块11的购买配对
+		{User: alice, Time: now.Add(-29 * time.Minute), BlockNumber: 12, Result: &contract.ScratchCardResult{}},
+	} {
+		app.apply(event)
+	}
+
+	check := app.stuck(now)
+	if check.Pending != 1 || len(check.Stuck) != 1 || check.Stuck[0].BlockNumber != 11 {
+		t.Fatalf("expected purchase at block 11 stuck, got %+v", check.Stuck)
+	}
+}
+
+func TestScratchCardApplication_Evict(t *testing.T) {
+	app := &ScratchCardApplication{pending: make(map[common.Address][]*ScratchCardRequest)}
+	alice, bob := common.HexToAddress("0xa1"), common.HexToAddress("0xb0")
+	for _, event := range []*contract.ScratchCardEvent{
+		{User: alice, BlockNumber: 1, TxHash: common.HexToHash("0x01")},
+		{User: bob, BlockNumber: 2, TxHash: common.HexToHash("0x02")},
+		{User: bob, BlockNumber: 5, TxHash: common.HexToHash("0x05")},
+	} {
+		app.apply(event)
+	}
+
+	app.evict(3)
+	if _, ok := app.pending[alice]; ok {
+		t.Fatal("expected alice evicted")
+	}
+	if requests := app.pending[bob]; len(requests) != 1 || requests[0].BlockNumber != 5 {
+		t.Fatalf("unexpected bob requests %+v", requests)
+	}
+}
@@ -0,0 +1,94 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/base/logx"
+)
+
+// Lifecycle 管理应用的根 context 和各组件的启动、停止。
+// 组件在构造时通过 Append 注册钩子，应用启动时按注册顺序启动，停止时按相反顺序停止。
+// 通过 AppendCheck 注册的启动检查与构造顺序无关，先于所有组件执行。
+type Lifecycle struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	checks  []Hook
+	hooks   []Hook
+	started int // 已启动的钩子数量
+}
+
+// Hook 组件的启动、停止钩子，OnStart 不应阻塞，OnStop 需在 ctx 结束前返回
+type Hook struct {
+	Name    string
+	OnStart func(ctx context.Context) error
+	OnStop  func(ctx context.Context) error
+}
+
+func New() (*Lifecycle, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	lc := &Lifecycle{ctx: ctx, cancel: cancel}
+	return lc, lc.Cancel
+}
+
+// Context 根 context，所有后台任务都应从它派生
+func (lc *Lifecycle) Context() context.Context {
+	return lc.ctx
+}
+
+// Cancel 取消根 context，进行中的任务随之中断
+func (lc *Lifecycle) Cancel() {
+	lc.cancel()
+}
+
+// Append 注册组件钩子，需在 Start 之前调用
+func (lc *Lifecycle) Append(hook Hook) {
+	lc.hooks = append(lc.hooks, hook)
+}
+
+// AppendCheck 注册启动检查（如配置自检），只使用 OnStart，需在 Start 之前调用
+func (lc *Lifecycle) AppendCheck(hook Hook) {
+	lc.checks = append(lc.checks, hook)
+}
+
+// Start 先执行所有启动检查，任一检查失败时不启动任何组件；
+// 再按注册顺序启动组件，任一组件启动失败时停止已启动的组件
+func (lc *Lifecycle) Start(ctx context.Context) error {
+	for _, check := range lc.checks {
+		if err := check.OnStart(ctx); err != nil {
+			return errorx.Wrap("start check failed", err, "name", check.Name)
+		}
+	}
+
+	for _, hook := range lc.hooks {
+		if hook.OnStart != nil {
+			if err := hook.OnStart(ctx); err != nil {
+				startErr := errorx.Wrap("fails to start component", err, "name", hook.Name)
+				return errors.Join(startErr, lc.Stop(ctx))
+			}
+		}
+		lc.started++
+		logx.Info("component started.", "name", hook.Name)
+	}
+	return nil
+}
+
+// Stop 按注册的相反顺序停止已启动的组件，单个组件停止失败不影响其他组件
+func (lc *Lifecycle) Stop(ctx context.Context) error {
+	var errs []error
+	for ; lc.started > 0; lc.started-- {
+		hook := lc.hooks[lc.started-1]
+		if hook.OnStop == nil {
+			continue
+		}
+
+		if err := hook.OnStop(ctx); err != nil {
+			errs = append(errs, errorx.Wrap("fails to stop component", err, "name", hook.Name))
+			continue
+		}
+		logx.Info("component stopped.", "name", hook.Name)
+	}
+	return errors.Join(errs...)
+}
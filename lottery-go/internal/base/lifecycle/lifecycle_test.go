@@ -0,0 +1,70 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestLifecycle_StartStopOrder(t *testing.T) {
+	lc, cancel := New()
+	defer cancel()
+
+	var events []string
+	for _, name := range []string{"a", "b", "c"} {
+		name := name
+		lc.Append(Hook{
+			Name: name,
+			OnStart: func(context.Context) error {
+				if name == "c" {
+					return errors.New("boom")
+				}
+				events = append(events, "start "+name)
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				events = append(events, "stop "+name)
+				return nil
+			},
+		})
+	}
+
+	// c 启动失败时，按相反顺序停止已启动的 a、b
+	if err := lc.Start(context.Background()); err == nil {
+		t.Fatal("expected start error")
+	}
+
+	expected := []string{"start a", "start b", "stop b", "stop a"}
+	if !reflect.DeepEqual(events, expected) {
+		t.Fatalf("unexpected events: %v", events)
+	}
+
+	// 再次停止不会重复执行
+	if err := lc.Stop(context.Background()); err != nil || len(events) != len(expected) {
+		t.Fatalf("unexpected stop: %v, %v", err, events)
+	}
+}
+
+func TestLifecycle_CheckBeforeHooks(t *testing.T) {
+	lc, cancel := New()
+	defer cancel()
+
+	var events []string
+	lc.Append(Hook{Name: "a", OnStart: func(context.Context) error {
+		events = append(events, "start a")
+		return nil
+	}})
+	// 后注册的启动检查先于所有组件执行，失败时不启动任何组件
+	lc.AppendCheck(Hook{Name: "check", OnStart: func(context.Context) error {
+		events = append(events, "check")
+		return errors.New("bad config")
+	}})
+
+	if err := lc.Start(context.Background()); err == nil {
+		t.Fatal("expected start error")
+	}
+	if !reflect.DeepEqual(events, []string{"check"}) {
+		t.Fatalf("unexpected events: %v", events)
+	}
+}
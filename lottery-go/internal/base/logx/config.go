@@ -2,6 +2,7 @@ package logx
 
 import (
 	"github.com/spf13/viper"
+	"log/slog"
 	"lottery-go/internal/config"
 )
 
@@ -11,7 +12,9 @@ func init() {
 }
 
 var cfg *Cfg
-var defaultLogger ILogger
+
+// defaultLogger 加载配置前（如单元测试）使用标准库默认日志
+var defaultLogger ILogger = &Slog{slog: slog.Default()}
 
 // =========== config info ===========
 
@@ -2,12 +2,21 @@ package logx
 
 import (
 	"fmt"
+	"io"
 )
 
 func Default() ILogger {
 	return defaultLogger
 }
 
+// Close 关闭默认日志打印器的日志文件，进程退出前调用
+func Close() error {
+	if closer, ok := defaultLogger.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 func WithModule(module string) ILogger {
 	return defaultLogger.WithModule(module)
 }
@@ -9,7 +9,8 @@ import (
 )
 
 type Slog struct {
-	slog *slog.Logger
+	slog   *slog.Logger
+	closer io.Closer // 日志文件，WithModule 创建的日志打印器共享同一个文件
 }
 
 func NewLogger(conf *LoggerCfg) ILogger {
@@ -24,7 +25,7 @@ func NewLogger(conf *LoggerCfg) ILogger {
 
 	handler := slog.NewTextHandler(writer, &slog.HandlerOptions{Level: getSlogLevel(conf.Level)})
 	log := slog.New(handler)
-	return &Slog{slog: log}
+	return &Slog{slog: log, closer: ljWriter}
 }
 
 // 设置日志等级
@@ -44,7 +45,15 @@ func getSlogLevel(setLevel string) *slog.LevelVar {
 }
 
 func (s *Slog) WithModule(module string) ILogger {
-	return &Slog{slog: s.slog.With("module", module)}
+	return &Slog{slog: s.slog.With("module", module), closer: s.closer}
+}
+
+// Close 关闭日志文件
+func (s *Slog) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
 }
 
 func (s *Slog) Debug(message string, args ...interface{}) {
@@ -2,32 +2,130 @@ package config
 
 import (
 	"github.com/spf13/viper"
+	"time"
 )
 
 // >>>>>>>>>>>>>>> contracts config info <<<<<<<<<<<<
 
 type Contracts struct {
-	DailyLottery *Contract `mapstructure:"daily-lottery"`
+	DailyLottery *Contract            `mapstructure:"daily-lottery"`
+	ScratchCard  *ScratchCardContract `mapstructure:"scratch-card"`
+	TxJournal    TxJournal            // 已广播交易的日志，进程崩溃后恢复未确认的交易
+}
+
+// TxJournal 交易日志存储配置
+type TxJournal struct {
+	Type string // 存储类型：bolt、memory
+	Path string // bolt 数据文件路径
 }
 
 type Contract struct {
-	RpcUrl     string
-	Address    string
-	PrivateKey string
+	RpcUrl        string        // 单个RPC节点，兼容旧配置；配置了RpcEndpoints时忽略
+	RpcEndpoints  []RpcEndpoint // 合约所在链的RPC节点列表，按优先级和健康度切换
+	HealthCheck   HealthCheck
+	ChainId       uint64 // 合约所在链的ID，启动自检和就绪检查时校验RPC节点，为0时不校验
+	Confirmations uint64 // 交易所在区块之后的确认数（含所在区块），达到后核对区块未被回滚才视为成功
+	Address       string
+	PrivateKey    string  // 已废弃：明文私钥，未配置 Signer 时使用，建议改用 keystore、keyFile 或 remote
+	Signer        Signer  // 发送交易的签名者
+	DrawVia       DrawVia // 开奖交易的发送方式，默认 direct
+	Simulate      bool    // 发送交易前模拟执行，会revert时不发送，默认开启
+	Timeout       Timeout
+	Gas           Gas
+}
+
+// ScratchCardContract 刮刮卡合约配置，与 daily-lottery 部署在同一条链上，共用RPC节点和签名者
+type ScratchCardContract struct {
+	Address        string        // 合约地址，为空时不启用刮刮卡的客户端和监控
+	Simulate       bool          // 发送交易前模拟执行，会revert时不发送，默认开启
+	ResultTimeout  time.Duration // 购买后超过该时间仍没有开奖结果，视为随机数回调丢失
+	LookbackBlocks uint64        // 启动时向前扫描的区块数，覆盖进程重启期间的购买
+	MaxBlockRange  uint64        // 单次查询事件的最大区块数，受RPC节点 eth_getLogs 的限制
+	Gas            Gas
+}
+
+// RpcEndpoint RPC节点，Priority 越小优先级越高
+type RpcEndpoint struct {
+	Url      string
+	Priority int
+}
+
+// HealthCheck RPC节点健康检查配置，未配置时使用默认值
+type HealthCheck struct {
+	Interval     time.Duration // 检查间隔
+	MaxBlockLag  uint64        // 落后最高区块超过该值视为不健康
+	MaxErrorRate float64       // 错误率超过该值视为不健康
+}
+
+// Signer 签名者配置
+type Signer struct {
+	Type         string // keystore：加密的keystore文件；keyFile：挂载的私钥文件；remote：Clef、Web3Signer
+	Keystore     string // keystore 文件路径
+	PasswordEnv  string // keystore 密码所在的环境变量，优先于 PasswordFile
+	PasswordFile string // keystore 密码文件路径
+	KeyFile      string // hex编码的私钥文件路径，如 k8s secret 挂载的文件
+	Url          string // 远程签名服务的JSON-RPC地址
+	Address      string // 远程签名服务中使用的账户地址
+}
+
+// DrawVia 开奖交易的发送方式
+type DrawVia struct {
+	Mode     string // direct：签名者直接调用 drawLottery，须为合约owner；executor：通过owner控制的执行合约转发
+	Executor string // 执行合约地址，须实现 execute(address target, bytes data)
+	Safe     string // 执行合约为Safe模块时，持有lottery所有权的Safe地址
+}
+
+// Timeout RPC调用超时配置，未配置时使用默认值
+type Timeout struct {
+	Call    time.Duration // view函数调用超时
+	Send    time.Duration // 发送交易超时
+	Confirm time.Duration // 等待交易确认超时
+}
+
+// Gas 交易的gas定价策略，未配置时使用默认值
+type Gas struct {
+	Legacy             bool    // 强制使用legacy交易，链不支持EIP-1559时自动使用
+	FeeHistoryBlocks   uint64  // 统计小费的最近区块数
+	TipPercentile      float64 // 取区块内小费的百分位
+	MinTipGwei         float64 // 最低小费
+	BaseFeeMultiplier  float64 // maxFee = baseFee * multiplier + tip
+	MaxFeeGwei         float64 // maxFee（legacy交易为gasPrice）的上限，超过时不发送交易，为0时不限制
+	GasLimitMargin     float64 // gasLimit 在估算值基础上增加的比例
+	ReplaceAfterBlocks uint64  // 交易经过多少个区块仍未上链时加价重发
+	BumpPercent        float64 // 加价重发、取消交易时的加价比例（%），不低于10
 }
 
 var contracts *Contracts
 
+// GetTxJournal get config info of the transaction journal
+func GetTxJournal() TxJournal {
+	return contracts.TxJournal
+}
+
 // DailyLottery get config info of the dailyLottery contract
 func DailyLottery() *Contract {
 	return contracts.DailyLottery
 }
 
+// ScratchCard get config info of the scratchCard contract
+func ScratchCard() *ScratchCardContract {
+	return contracts.ScratchCard
+}
+
 // >>>>>>>>>>>>>>> Contracts Loader <<<<<<<<<<<<<
 
 type ContractsLoader struct{}
 
 func (loader *ContractsLoader) Load(conf *viper.Viper) error {
+	conf.SetDefault("txJournal.type", "bolt")
+	conf.SetDefault("txJournal.path", "data/tx_journal.db")
+	conf.SetDefault("daily-lottery.simulate", true)
+	conf.SetDefault("daily-lottery.drawVia.mode", "direct")
+	conf.SetDefault("scratch-card.simulate", true)
+	conf.SetDefault("scratch-card.resultTimeout", "10m")
+	conf.SetDefault("scratch-card.lookbackBlocks", 7200)
+	conf.SetDefault("scratch-card.maxBlockRange", 1000)
+
 	if err := conf.Unmarshal(&contracts); err != nil {
 		return err
 	}
@@ -29,6 +29,10 @@ func LoadAll() error {
 	for name, loader := range loaders {
 		// 获取各组件配置
 		cfg := viperConfig.Sub(name)
+		if cfg == nil {
+			// 未配置的组件使用空配置，由组件自行填充默认值
+			cfg = viper.New()
+		}
 
 		if err := loader.Load(cfg); err != nil {
 			return fmt.Errorf("load config for %s failed: %w", name, err)
@@ -1,82 +1,55 @@
 package contract
 
-// 合约ABI定义
-const dailyLotteryContractABI = `[
-    {
-        "type": "function",
-        "name": "lotteryNumber",
-        "inputs": [
+import (
+	"context"
 
-        ],
-        "outputs": [
-            {
-                "name": "",
-                "type": "uint64",
-                "internalType": "uint64"
-            }
-        ],
-        "stateMutability": "view"
-    },
-    {
-        "type": "function",
-        "name": "getDrawState",
-        "inputs": [
-            {
-                "name": "_lotteryNumber",
-                "type": "uint64",
-                "internalType": "uint64"
-            }
-        ],
-        "outputs": [
-            {
-                "name": "",
-                "type": "uint8",
-                "internalType": "enum LotteryDrawState"
-            }
-        ],
-        "stateMutability": "view"
-    },
-    {
-        "type": "function",
-        "name": "drawLottery",
-        "inputs": [
-			{
-                "name": "_lotteryNumber",
-                "type": "uint64",
-                "internalType": "uint64"
-            }
-        ],
-        "outputs": [
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/contract/abis"
+	"lottery-go/internal/contract/bindings"
+	"lottery-go/internal/pkg/eth"
+)
 
-        ],
-        "stateMutability": "nonpayable"
-    }
-]`
+var (
+	// dailyLottery DailyLotteryV1 的类型安全绑定，用于编码调用数据和解码返回值
+	dailyLottery = bindings.NewDailyLotteryV1()
 
-const dailyLotteryErrorABI = `
-	[
-		{
-			"inputs": [
-	
-			],
-			"name": "DrawingInProgress",
-			"type": "error"
-		},
-		{
-			"type": "error",
-			"name": "MinDrawIntervalNotMet",
-			"inputs": [
-				{
-					"name": "startTime",
-					"type": "uint256",
-					"internalType": "uint256"
-				},
-				{
-					"name": "currentTime",
-					"type": "uint256",
-					"internalType": "uint256"
-				}
-			]
-		}
-	]
-`
+	// dailyLotteryErrorABI DailyLotteryV1 的自定义错误（含继承的 Ownable 错误），以及VRF provider 冒泡的错误
+	dailyLotteryErrorABI = abis.MustErrors(abis.DailyLotteryV1, abis.DailyLotteryVRFProvider)
+
+	// scratchCard、scratchCardConfig ScratchCardV1 及其配置合约的类型安全绑定
+	scratchCard       = bindings.NewScratchCardV1()
+	scratchCardConfig = bindings.NewScratchCardConfigV1()
+
+	// scratchCardErrorABI ScratchCardV1 的自定义错误（含继承的 Ownable 错误），以及VRF provider 冒泡的错误
+	scratchCardErrorABI = abis.MustErrors(abis.ScratchCardV1, abis.ScratchCardVRFProvider)
+
+	// scratchCardEventTopic、lotteryResultEventTopic 刮刮卡购买和开奖结果事件的签名，用于过滤日志
+	scratchCardEventTopic   = eventTopic(abis.ScratchCardV1, bindings.ScratchCardV1ScratchCardEventEventName)
+	lotteryResultEventTopic = eventTopic(abis.ScratchCardV1, bindings.ScratchCardV1LotteryResultEventEventName)
+)
+
+// eventTopic 事件签名的哈希，即日志的第一个topic
+func eventTopic(contractName, event string) common.Hash {
+	parsed, err := abis.Load(contractName)
+	if err != nil {
+		panic(err)
+	}
+	return parsed.Events[event].ID
+}
+
+// callView 执行绑定编码的view调用，并用绑定的 UnpackXxx 解码返回值
+func callView[T any](ctx context.Context, client *eth.Client, address common.Address, funcName string,
+	data []byte, unpack func([]byte) (T, error)) (T, error) {
+	var zero T
+	res, err := client.Call(ctx, address, funcName, data)
+	if err != nil {
+		return zero, err
+	}
+
+	result, err := unpack(res)
+	if err != nil {
+		return zero, errorx.Wrap("failed to unpack result", err, "function", funcName)
+	}
+	return result, nil
+}
@@ -0,0 +1,90 @@
+// Package abis 内嵌合约的ABI，由 go generate 从 Foundry 编译产物 lottery-contract/out 提取，
+// 修改合约后执行 forge build && go generate ./internal/contract/abis 更新
+package abis
+
+//go:generate go run gen.go -out ../../../../lottery-contract/out
+
+import (
+	"embed"
+	"encoding/json"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"lottery-go/internal/base/errorx"
+)
+
+// 合约名称，与 Solidity 合约名和ABI文件名一致
+const (
+	DailyLotteryV1          = "DailyLotteryV1"
+	DailyLotteryConfigV1    = "DailyLotteryConfigV1"
+	DailyLotteryTokenV1     = "DailyLotteryTokenV1"
+	DailyLotteryVRFProvider = "DailyLotteryVRFProvider"
+	ScratchCardV1           = "ScratchCardV1"
+	ScratchCardConfigV1     = "ScratchCardConfigV1"
+	ScratchCardTokenV1      = "ScratchCardTokenV1"
+	ScratchCardVRFProvider  = "ScratchCardVRFProvider"
+)
+
+// Contracts 内嵌ABI的合约，go generate 按该列表提取
+var Contracts = []string{
+	DailyLotteryV1, DailyLotteryConfigV1, DailyLotteryTokenV1, DailyLotteryVRFProvider,
+	ScratchCardV1, ScratchCardConfigV1, ScratchCardTokenV1, ScratchCardVRFProvider,
+}
+
+//go:embed *.json
+var files embed.FS
+
+// JSON 合约ABI的JSON字符串
+func JSON(name string) (string, error) {
+	data, err := files.ReadFile(name + ".json")
+	if err != nil {
+		return "", errorx.Wrap("abi not found", err, "contract", name)
+	}
+	return string(data), nil
+}
+
+// MustJSON 同 JSON，ABI不存在时panic，用于包级变量初始化
+func MustJSON(name string) string {
+	data, err := JSON(name)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// Load 解析合约ABI
+func Load(name string) (abi.ABI, error) {
+	data, err := JSON(name)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(data))
+	if err != nil {
+		return abi.ABI{}, errorx.Wrap("fails to parse abi", err, "contract", name)
+	}
+	return parsed, nil
+}
+
+// MustErrors 合并多个合约ABI中的自定义错误，用于解析跨合约冒泡的revert，如VRF provider的错误
+func MustErrors(names ...string) string {
+	var errs []json.RawMessage
+	for _, name := range names {
+		var entries []json.RawMessage
+		if err := json.Unmarshal([]byte(MustJSON(name)), &entries); err != nil {
+			panic(errorx.Wrap("fails to parse abi", err, "contract", name))
+		}
+
+		for _, entry := range entries {
+			var head struct {
+				Type string `json:"type"`
+			}
+			if err := json.Unmarshal(entry, &head); err == nil && head.Type == "error" {
+				errs = append(errs, entry)
+			}
+		}
+	}
+
+	data, _ := json.Marshal(errs)
+	return string(data)
+}
@@ -0,0 +1,425 @@
+package abis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	srcDir = "../../../../lottery-contract/src"
+	outDir = "../../../../lottery-contract/out"
+)
+
+// inherited 基类合约引入的ABI条目，不在合约源码中声明
+var inherited = map[string][]string{
+	"Ownable": {"owner", "renounceOwnership", "transferOwnership",
+		"OwnershipTransferred", "OwnableInvalidOwner", "OwnableUnauthorizedAccount"},
+	"OwnableUpgradeable": {"owner", "renounceOwnership", "transferOwnership",
+		"OwnershipTransferred", "OwnableInvalidOwner", "OwnableUnauthorizedAccount"},
+	"Initializable": {"Initialized", "InvalidInitialization", "NotInitializing"},
+	"UUPSUpgradeable": {"UPGRADE_INTERFACE_VERSION", "proxiableUUID", "upgradeToAndCall", "Upgraded",
+		"AddressEmptyCode", "ERC1967InvalidImplementation", "ERC1967NonPayable", "FailedCall",
+		"UUPSUnauthorizedCallContext", "UUPSUnsupportedProxiableUUID"},
+	"ERC721": {"approve", "balanceOf", "getApproved", "isApprovedForAll", "name", "ownerOf",
+		"safeTransferFrom", "setApprovalForAll", "symbol", "transferFrom",
+		"Approval", "ApprovalForAll", "Transfer",
+		"ERC721IncorrectOwner", "ERC721InsufficientApproval", "ERC721InvalidApprover", "ERC721InvalidOperator",
+		"ERC721InvalidOwner", "ERC721InvalidReceiver", "ERC721InvalidSender", "ERC721NonexistentToken"},
+	"ERC721URIStorage": {"MetadataUpdate", "BatchMetadataUpdate"},
+	"ERC721Pausable":   {"paused", "Paused", "Unpaused", "EnforcedPause", "ExpectedPause"},
+	"VRFConsumerBaseV2Plus": {"acceptOwnership", "owner", "rawFulfillRandomWords", "s_vrfCoordinator",
+		"setCoordinator", "transferOwnership", "CoordinatorSet", "OwnershipTransferRequested",
+		"OwnershipTransferred", "OnlyCoordinatorCanFulfill", "OnlyOwnerOrCoordinator", "ZeroAddress"},
+}
+
+var (
+	blockComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	lineComment  = regexp.MustCompile(`//[^\n]*`)
+	contractDecl = regexp.MustCompile(`contract\s+(\w+)\s*(?:is\s+([^{]+))?\{`)
+	typeDecl     = regexp.MustCompile(`\b(contract|interface|enum|struct)\s+(\w+)`)
+	structDecl   = regexp.MustCompile(`struct\s+(\w+)\s*\{([^}]*)\}`)
+	functionDecl = regexp.MustCompile(`\bfunction\s+(\w+)\s*\(`)
+	ctorDecl     = regexp.MustCompile(`\bconstructor\s*\(([^)]*)\)`)
+	eventDecl    = regexp.MustCompile(`\bevent\s+(\w+)\s*\(([^;]*)\)\s*;`)
+	errorDecl    = regexp.MustCompile(`\berror\s+(\w+)\s*\(([^;]*)\)\s*;`)
+	stateVarDecl = regexp.MustCompile(`(?m)^\s*(mapping\s*\(.*\)|[\w.]+(?:\[\])*)\s+public\s+(?:constant\s+|immutable\s+)?(\w+)\s*(?:=[^;]*)?;`)
+	mappingDecl  = regexp.MustCompile(`^mapping\s*\(\s*(\w+)(?:\s+\w+)?\s*=>\s*(.*)\)$`)
+)
+
+// source 从 Solidity 源码解析出的合约接口，值为规范化的签名
+type source struct {
+	bases     []string
+	ctor      string
+	functions map[string][]string // name => ["name(inputs) mutability returns(outputs)"]
+	events    map[string]string   // name => "name(inputs) indexed=[...]"
+	errors    map[string]string   // name => "name(inputs)"
+}
+
+// solTypes 源码中的类型定义：合约和接口编码为address，枚举为uint8，结构体为tuple
+type solTypes struct {
+	addresses map[string]bool
+	enums     map[string]bool
+	structs   map[string][]string // 结构体成员类型
+}
+
+func stripComments(code string) string {
+	return lineComment.ReplaceAllString(blockComment.ReplaceAllString(code, ""), "")
+}
+
+func loadTypes(t *testing.T) *solTypes {
+	types := &solTypes{addresses: map[string]bool{}, enums: map[string]bool{}, structs: map[string][]string{}}
+	err := filepath.WalkDir(srcDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".sol") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		code := stripComments(string(data))
+		for _, m := range typeDecl.FindAllStringSubmatch(code, -1) {
+			switch m[1] {
+			case "contract", "interface":
+				types.addresses[m[2]] = true
+			case "enum":
+				types.enums[m[2]] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("fails to read solidity sources, %v", err)
+	}
+	return types
+}
+
+// withStructs 合约文件内定义的结构体，不同合约可能定义同名结构体
+func (types *solTypes) withStructs(code string) *solTypes {
+	local := &solTypes{addresses: types.addresses, enums: types.enums, structs: map[string][]string{}}
+	for _, m := range structDecl.FindAllStringSubmatch(code, -1) {
+		for _, member := range strings.Split(m[2], ";") {
+			if fields := strings.Fields(member); len(fields) > 0 {
+				local.structs[m[1]] = append(local.structs[m[1]], local.canon(fields[0]))
+			}
+		}
+	}
+	return local
+}
+
+// canon 规范化为ABI类型
+func (types *solTypes) canon(typ string) string {
+	base, suffix := typ, ""
+	if i := strings.Index(typ, "["); i >= 0 {
+		base, suffix = typ[:i], typ[i:]
+	}
+	if i := strings.LastIndex(base, "."); i >= 0 {
+		base = base[i+1:]
+	}
+
+	switch {
+	case base == "uint" || base == "int":
+		base += "256"
+	case types.enums[base]:
+		base = "uint8"
+	case types.addresses[base]:
+		base = "address"
+	case types.structs[base] != nil:
+		base = "(" + strings.Join(types.structs[base], ",") + ")"
+	}
+	return base + suffix
+}
+
+// params 规范化参数列表，返回类型和是否indexed
+func (types *solTypes) params(list string) ([]string, []bool) {
+	var typeList []string
+	var indexed []bool
+	for _, param := range strings.Split(list, ",") {
+		fields := strings.Fields(param)
+		if len(fields) == 0 {
+			continue
+		}
+		typeList = append(typeList, types.canon(fields[0]))
+		indexed = append(indexed, slices.Contains(fields, "indexed"))
+	}
+	return typeList, indexed
+}
+
+// getter public状态变量的getter签名，结构体返回成员
+func (types *solTypes) getter(name, typ string) string {
+	var inputs []string
+	for {
+		m := mappingDecl.FindStringSubmatch(typ)
+		if m == nil {
+			break
+		}
+		inputs = append(inputs, types.canon(m[1]))
+		typ = strings.TrimSpace(m[2])
+		if !strings.HasPrefix(typ, "mapping") {
+			typ = strings.Fields(typ)[0] // 去掉命名的值，如 string tokenUri
+		}
+	}
+	for strings.HasSuffix(typ, "[]") {
+		inputs = append(inputs, "uint256")
+		typ = strings.TrimSuffix(typ, "[]")
+	}
+
+	outputs := []string{types.canon(typ)}
+	if members, ok := types.structs[typ]; ok {
+		outputs = members
+	}
+	return fmt.Sprintf("%s(%s) view returns(%s)", name, strings.Join(inputs, ","), strings.Join(outputs, ","))
+}
+
+// closing 返回与 open 处左括号匹配的右括号位置
+func closing(code string, open int) int {
+	depth := 0
+	for i := open; i < len(code); i++ {
+		switch code[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func parseSource(t *testing.T, types *solTypes, name string) *source {
+	var path string
+	_ = filepath.WalkDir(srcDir, func(p string, d os.DirEntry, err error) error {
+		if err == nil && d.Name() == name+".sol" {
+			path = p
+		}
+		return err
+	})
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("source of %s not found, %v", name, err)
+	}
+	code := stripComments(string(data))
+	types = types.withStructs(code)
+
+	src := &source{functions: map[string][]string{}, events: map[string]string{}, errors: map[string]string{}}
+	if m := contractDecl.FindStringSubmatch(code); m != nil {
+		for _, base := range strings.Split(m[2], ",") {
+			if base = strings.TrimSpace(base); base != "" {
+				src.bases = append(src.bases, base)
+			}
+		}
+	}
+	if m := ctorDecl.FindStringSubmatch(code); m != nil {
+		inputs, _ := types.params(m[1])
+		src.ctor = "(" + strings.Join(inputs, ",") + ")"
+	}
+
+	for _, loc := range functionDecl.FindAllStringSubmatchIndex(code, -1) {
+		fnName := code[loc[2]:loc[3]]
+		end := closing(code, loc[1]-1)
+		modifiers := code[end+1:]
+		modifiers = modifiers[:strings.IndexAny(modifiers, "{;")]
+		if !strings.Contains(modifiers, "public") && !strings.Contains(modifiers, "external") {
+			continue
+		}
+
+		mutability := "nonpayable"
+		for _, m := range []string{"view", "pure", "payable"} {
+			if slices.Contains(strings.Fields(strings.NewReplacer("(", " ", ")", " ").Replace(modifiers)), m) {
+				mutability = m
+			}
+		}
+		var outputs []string
+		if i := strings.Index(modifiers, "returns"); i >= 0 {
+			open := i + strings.Index(modifiers[i:], "(")
+			outputs, _ = types.params(modifiers[open+1 : closing(modifiers, open)])
+		}
+		inputs, _ := types.params(code[loc[1]:end])
+		src.functions[fnName] = append(src.functions[fnName], fmt.Sprintf("%s(%s) %s returns(%s)",
+			fnName, strings.Join(inputs, ","), mutability, strings.Join(outputs, ",")))
+	}
+
+	for _, m := range stateVarDecl.FindAllStringSubmatch(code, -1) {
+		src.functions[m[2]] = append(src.functions[m[2]], types.getter(m[2], m[1]))
+	}
+	for _, m := range eventDecl.FindAllStringSubmatch(code, -1) {
+		inputs, indexed := types.params(m[2])
+		src.events[m[1]] = fmt.Sprintf("%s(%s) indexed=%v", m[1], strings.Join(inputs, ","), indexed)
+	}
+	for _, m := range errorDecl.FindAllStringSubmatch(code, -1) {
+		inputs, _ := types.params(m[2])
+		src.errors[m[1]] = fmt.Sprintf("%s(%s)", m[1], strings.Join(inputs, ","))
+	}
+	return src
+}
+
+func abiTypes(args abi.Arguments) []string {
+	typeList := make([]string, len(args))
+	for i, arg := range args {
+		typeList[i] = arg.Type.String()
+	}
+	return typeList
+}
+
+func methodSig(method abi.Method) string {
+	return fmt.Sprintf("%s(%s) %s returns(%s)", method.RawName, strings.Join(abiTypes(method.Inputs), ","),
+		method.StateMutability, strings.Join(abiTypes(method.Outputs), ","))
+}
+
+func eventSig(event abi.Event) string {
+	indexed := make([]bool, len(event.Inputs))
+	for i, input := range event.Inputs {
+		indexed[i] = input.Indexed
+	}
+	return fmt.Sprintf("%s(%s) indexed=%v", event.RawName, strings.Join(abiTypes(event.Inputs), ","), indexed)
+}
+
+func errorSig(abiErr abi.Error) string {
+	return fmt.Sprintf("%s(%s)", abiErr.Name, strings.Join(abiTypes(abiErr.Inputs), ","))
+}
+
+// TestABIMatchesSources 内嵌ABI与 Solidity 源码声明的函数、事件、错误一致，
+// ABI中未在源码声明的条目必须来自已知的基类合约
+func TestABIMatchesSources(t *testing.T) {
+	if _, err := os.Stat(srcDir); err != nil {
+		t.Skipf("solidity sources not found, %v", err)
+	}
+	types := loadTypes(t)
+
+	for _, name := range Contracts {
+		t.Run(name, func(t *testing.T) {
+			parsed, err := Load(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			src := parseSource(t, types, name)
+
+			allowed := map[string]bool{}
+			for _, base := range src.bases {
+				for _, entry := range inherited[base] {
+					allowed[entry] = true
+				}
+			}
+
+			// 源码 => ABI
+			for fnName, sigs := range src.functions {
+				var abiSigs []string
+				for _, method := range parsed.Methods {
+					if method.RawName == fnName {
+						abiSigs = append(abiSigs, methodSig(method))
+					}
+				}
+				for _, sig := range sigs {
+					if !slices.Contains(abiSigs, sig) {
+						t.Errorf("function %s declared in source, abi has %v", sig, abiSigs)
+					}
+				}
+			}
+			for evName, sig := range src.events {
+				if event, ok := parsed.Events[evName]; !ok || eventSig(event) != sig {
+					t.Errorf("event %s declared in source, abi has %q", sig, eventSig(event))
+				}
+			}
+			for errName, sig := range src.errors {
+				if abiErr, ok := parsed.Errors[errName]; !ok || errorSig(abiErr) != sig {
+					t.Errorf("error %s declared in source, abi has %q", sig, errorSig(abiErr))
+				}
+			}
+			if ctor := "(" + strings.Join(abiTypes(parsed.Constructor.Inputs), ",") + ")"; src.ctor != "" && ctor != src.ctor {
+				t.Errorf("constructor %s declared in source, abi has %s", src.ctor, ctor)
+			}
+
+			// ABI => 源码或基类
+			for _, method := range parsed.Methods {
+				if _, ok := src.functions[method.RawName]; !ok && !allowed[method.RawName] {
+					t.Errorf("function %s not declared in source", methodSig(method))
+				}
+			}
+			for _, event := range parsed.Events {
+				if _, ok := src.events[event.RawName]; !ok && !allowed[event.RawName] {
+					t.Errorf("event %s not declared in source", eventSig(event))
+				}
+			}
+			for _, abiErr := range parsed.Errors {
+				if _, ok := src.errors[abiErr.Name]; !ok && !allowed[abiErr.Name] {
+					t.Errorf("error %s not declared in source", errorSig(abiErr))
+				}
+			}
+		})
+	}
+}
+
+// abiEntries ABI的规范化签名，用于与编译产物比较
+func abiEntries(parsed abi.ABI) []string {
+	var entries []string
+	for _, method := range parsed.Methods {
+		entries = append(entries, "function "+methodSig(method))
+	}
+	for _, event := range parsed.Events {
+		entries = append(entries, "event "+eventSig(event))
+	}
+	for _, abiErr := range parsed.Errors {
+		entries = append(entries, "error "+errorSig(abiErr))
+	}
+	slices.Sort(entries)
+	return entries
+}
+
+// TestABIMatchesArtifacts 存在 forge build 产物时，内嵌ABI与产物一致，否则需要执行 go generate
+func TestABIMatchesArtifacts(t *testing.T) {
+	if _, err := os.Stat(outDir); err != nil {
+		t.Skip("foundry artifacts not found, run forge build to enable")
+	}
+
+	for _, name := range Contracts {
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join(outDir, name+".sol", name+".json"))
+			if err != nil {
+				t.Fatalf("artifact of %s not found, %v", name, err)
+			}
+			var artifact struct {
+				Abi json.RawMessage `json:"abi"`
+			}
+			if err := json.Unmarshal(data, &artifact); err != nil {
+				t.Fatal(err)
+			}
+			built, err := abi.JSON(strings.NewReader(string(artifact.Abi)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			embedded, err := Load(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(abiEntries(built), abiEntries(embedded)) {
+				t.Errorf("embedded abi of %s is out of date, run go generate ./internal/contract/abis", name)
+			}
+		})
+	}
+}
+
+func TestMustErrors(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(MustErrors(DailyLotteryV1, DailyLotteryVRFProvider)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.Methods) != 0 || len(parsed.Events) != 0 {
+		t.Fatal("expected errors only")
+	}
+	for _, name := range []string{"MinDrawIntervalNotMet", "OwnableUnauthorizedAccount", "VRFRequestAlreadyRequested"} {
+		if _, ok := parsed.Errors[name]; !ok {
+			t.Errorf("error %s not found", name)
+		}
+	}
+}
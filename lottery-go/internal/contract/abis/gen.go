@@ -0,0 +1,45 @@
+//go:build ignore
+
+// gen 从 Foundry 编译产物 out/<Contract>.sol/<Contract>.json 提取ABI，写入当前目录的 <Contract>.json
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"lottery-go/internal/contract/abis"
+)
+
+func main() {
+	out := flag.String("out", "../../../../lottery-contract/out", "Foundry out directory")
+	flag.Parse()
+
+	for _, name := range abis.Contracts {
+		data, err := os.ReadFile(filepath.Join(*out, name+".sol", name+".json"))
+		if err != nil {
+			log.Fatalf("fails to read artifact of %s, run forge build first. %v", name, err)
+		}
+
+		var artifact struct {
+			Abi json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(data, &artifact); err != nil {
+			log.Fatalf("fails to parse artifact of %s. %v", name, err)
+		}
+
+		// 保持 forge 输出的字段顺序
+		var formatted bytes.Buffer
+		if err := json.Indent(&formatted, artifact.Abi, "", "  "); err != nil {
+			log.Fatalf("fails to parse abi of %s. %v", name, err)
+		}
+		formatted.WriteByte('\n')
+		if err := os.WriteFile(name+".json", formatted.Bytes(), 0o644); err != nil {
+			log.Fatalf("fails to write abi of %s. %v", name, err)
+		}
+		log.Printf("abi of %s updated.", name)
+	}
+}
@@ -0,0 +1,12 @@
+// Package bindings 由 abigen --v2 根据 abis 包内嵌的ABI生成的类型安全合约绑定，
+// 更新ABI后执行 go generate ./internal/contract/bindings 重新生成，请勿手动修改生成的文件
+package bindings
+
+//go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --v2 --abi ../abis/DailyLotteryV1.json --pkg bindings --type DailyLotteryV1 --out daily_lottery_v1.go
+//go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --v2 --abi ../abis/DailyLotteryConfigV1.json --pkg bindings --type DailyLotteryConfigV1 --out daily_lottery_config_v1.go
+//go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --v2 --abi ../abis/DailyLotteryTokenV1.json --pkg bindings --type DailyLotteryTokenV1 --out daily_lottery_token_v1.go
+//go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --v2 --abi ../abis/DailyLotteryVRFProvider.json --pkg bindings --type DailyLotteryVRFProvider --out daily_lottery_vrf_provider.go
+//go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --v2 --abi ../abis/ScratchCardV1.json --pkg bindings --type ScratchCardV1 --out scratch_card_v1.go
+//go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --v2 --abi ../abis/ScratchCardConfigV1.json --pkg bindings --type ScratchCardConfigV1 --out scratch_card_config_v1.go
+//go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --v2 --abi ../abis/ScratchCardTokenV1.json --pkg bindings --type ScratchCardTokenV1 --out scratch_card_token_v1.go
+//go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --v2 --abi ../abis/ScratchCardVRFProvider.json --pkg bindings --type ScratchCardVRFProvider --out scratch_card_vrf_provider.go
@@ -0,0 +1,121 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// DailyLotteryConfigV1MetaData contains all meta data concerning the DailyLotteryConfigV1 contract.
+var DailyLotteryConfigV1MetaData = bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"FeeRate\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"PricePerNumber\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"}]",
+	ID:  "DailyLotteryConfigV1",
+}
+
+// DailyLotteryConfigV1 is an auto generated Go binding around an Ethereum contract.
+type DailyLotteryConfigV1 struct {
+	abi abi.ABI
+}
+
+// NewDailyLotteryConfigV1 creates a new instance of DailyLotteryConfigV1.
+func NewDailyLotteryConfigV1() *DailyLotteryConfigV1 {
+	parsed, err := DailyLotteryConfigV1MetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &DailyLotteryConfigV1{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *DailyLotteryConfigV1) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackFeeRate is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xabe2a16e.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function FeeRate() pure returns(uint8)
+func (dailyLotteryConfigV1 *DailyLotteryConfigV1) PackFeeRate() []byte {
+	enc, err := dailyLotteryConfigV1.abi.Pack("FeeRate")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackFeeRate is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xabe2a16e.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function FeeRate() pure returns(uint8)
+func (dailyLotteryConfigV1 *DailyLotteryConfigV1) TryPackFeeRate() ([]byte, error) {
+	return dailyLotteryConfigV1.abi.Pack("FeeRate")
+}
+
+// UnpackFeeRate is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xabe2a16e.
+//
+// Solidity: function FeeRate() pure returns(uint8)
+func (dailyLotteryConfigV1 *DailyLotteryConfigV1) UnpackFeeRate(data []byte) (uint8, error) {
+	out, err := dailyLotteryConfigV1.abi.Unpack("FeeRate", data)
+	if err != nil {
+		return *new(uint8), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+	return out0, nil
+}
+
+// PackPricePerNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb01e399f.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function PricePerNumber() pure returns(uint256)
+func (dailyLotteryConfigV1 *DailyLotteryConfigV1) PackPricePerNumber() []byte {
+	enc, err := dailyLotteryConfigV1.abi.Pack("PricePerNumber")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackPricePerNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb01e399f.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function PricePerNumber() pure returns(uint256)
+func (dailyLotteryConfigV1 *DailyLotteryConfigV1) TryPackPricePerNumber() ([]byte, error) {
+	return dailyLotteryConfigV1.abi.Pack("PricePerNumber")
+}
+
+// UnpackPricePerNumber is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xb01e399f.
+//
+// Solidity: function PricePerNumber() pure returns(uint256)
+func (dailyLotteryConfigV1 *DailyLotteryConfigV1) UnpackPricePerNumber(data []byte) (*big.Int, error) {
+	out, err := dailyLotteryConfigV1.abi.Unpack("PricePerNumber", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
@@ -0,0 +1,1708 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// DailyLotteryV1WinnerData is an auto generated low-level Go binding around an user-defined struct.
+type DailyLotteryV1WinnerData struct {
+	Winner        common.Address
+	TokenId       *big.Int
+	Number        uint64
+	LotteryNumber uint64
+}
+
+// DailyLotteryV1MetaData contains all meta data concerning the DailyLotteryV1 contract.
+var DailyLotteryV1MetaData = bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"UPGRADE_INTERFACE_VERSION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"callbackFromRand\",\"inputs\":[{\"name\":\"_randomNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"configContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIDailyLotteryConfig\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"drawLottery\",\"inputs\":[{\"name\":\"_lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getAddressByNumber\",\"inputs\":[{\"name\":\"_lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"_number\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getDrawState\",\"inputs\":[{\"name\":\"_lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumLotteryDrawState\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getDrawTime\",\"inputs\":[{\"name\":\"_lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getFeeRate\",\"inputs\":[{\"name\":\"_lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerNumber\",\"inputs\":[{\"name\":\"_lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTotalAmount\",\"inputs\":[{\"name\":\"_lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getWinnerData\",\"inputs\":[{\"name\":\"_lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structDailyLotteryV1.WinnerData\",\"components\":[{\"name\":\"winner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"number\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_nftAddr\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_numberLogicAddr\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_randProviderAddr\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_configAddr\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"lotteryNumber\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lotterys\",\"inputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"pricePerNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feeRate\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"totalAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"fee\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"prize\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"drawState\",\"type\":\"uint8\",\"internalType\":\"enumLotteryDrawState\"},{\"name\":\"drawTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"minDrawInterval\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"nftContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIDailyLotteryToken\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"numberLogicContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIDailyLotteryNumberLogic\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"numberToUser\",\"inputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"proxiableUUID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"randProviderContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIDailyLotteryRandProvider\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setMinDrawInterval\",\"inputs\":[{\"name\":\"_minDrawInterval\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setNftAddress\",\"inputs\":[{\"name\":\"_nftAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setNumberLogicAddress\",\"inputs\":[{\"name\":\"_numberLogicAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setRandProviderAddress\",\"inputs\":[{\"name\":\"_randProviderAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"takeNumbers\",\"inputs\":[{\"name\":\"nums\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint64[]\",\"internalType\":\"uint64[]\"}],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"updateConfigAddress\",\"inputs\":[{\"name\":\"_configAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"upgradeToAndCall\",\"inputs\":[{\"name\":\"newImplementation\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"winners\",\"inputs\":[{\"name\":\"lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"winner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"number\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"lotteryNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"LotteryDrawnEvent\",\"inputs\":[{\"name\":\"lotteryNumber\",\"type\":\"uint64\",\"indexed\":true,\"internalType\":\"uint64\"},{\"name\":\"winner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"winnerNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"fee\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"prize\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"drawTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TakeNumbersEvent\",\"inputs\":[{\"name\":\"lotteryNumber\",\"type\":\"uint64\",\"indexed\":true,\"internalType\":\"uint64\"},{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"numbers\",\"type\":\"uint64[]\",\"internalType\":\"uint64[]\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Upgraded\",\"inputs\":[{\"name\":\"implementation\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AddressEmptyCode\",\"inputs\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"DrawingInProgress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ERC1967InvalidImplementation\",\"inputs\":[{\"name\":\"implementation\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC1967NonPayable\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"FailedCall\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MinDrawIntervalNotMet\",\"inputs\":[{\"name\":\"startTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"currentTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"NoNumbersToDraw\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OnlyRandProvider\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"TransferFailed\",\"inputs\":[{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"UUPSUnauthorizedCallContext\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UUPSUnsupportedProxiableUUID\",\"inputs\":[{\"name\":\"slot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"WrongEthValue\",\"inputs\":[{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"WrongLotteryNumber\",\"inputs\":[{\"name\":\"param\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"current\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}]",
+	ID:  "DailyLotteryV1",
+}
+
+// DailyLotteryV1 is an auto generated Go binding around an Ethereum contract.
+type DailyLotteryV1 struct {
+	abi abi.ABI
+}
+
+// NewDailyLotteryV1 creates a new instance of DailyLotteryV1.
+func NewDailyLotteryV1() *DailyLotteryV1 {
+	parsed, err := DailyLotteryV1MetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &DailyLotteryV1{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *DailyLotteryV1) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackUPGRADEINTERFACEVERSION is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xad3cb1cc.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (dailyLotteryV1 *DailyLotteryV1) PackUPGRADEINTERFACEVERSION() []byte {
+	enc, err := dailyLotteryV1.abi.Pack("UPGRADE_INTERFACE_VERSION")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackUPGRADEINTERFACEVERSION is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xad3cb1cc.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackUPGRADEINTERFACEVERSION() ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("UPGRADE_INTERFACE_VERSION")
+}
+
+// UnpackUPGRADEINTERFACEVERSION is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackUPGRADEINTERFACEVERSION(data []byte) (string, error) {
+	out, err := dailyLotteryV1.abi.Unpack("UPGRADE_INTERFACE_VERSION", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// PackCallbackFromRand is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf040c031.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function callbackFromRand(uint256 _randomNumber) returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackCallbackFromRand(randomNumber *big.Int) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("callbackFromRand", randomNumber)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackCallbackFromRand is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf040c031.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function callbackFromRand(uint256 _randomNumber) returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackCallbackFromRand(randomNumber *big.Int) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("callbackFromRand", randomNumber)
+}
+
+// PackConfigContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xbf66a182.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function configContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) PackConfigContract() []byte {
+	enc, err := dailyLotteryV1.abi.Pack("configContract")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackConfigContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xbf66a182.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function configContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackConfigContract() ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("configContract")
+}
+
+// UnpackConfigContract is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xbf66a182.
+//
+// Solidity: function configContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackConfigContract(data []byte) (common.Address, error) {
+	out, err := dailyLotteryV1.abi.Unpack("configContract", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackDrawLottery is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf243140c.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function drawLottery(uint64 _lotteryNumber) returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackDrawLottery(lotteryNumber uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("drawLottery", lotteryNumber)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackDrawLottery is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf243140c.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function drawLottery(uint64 _lotteryNumber) returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackDrawLottery(lotteryNumber uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("drawLottery", lotteryNumber)
+}
+
+// PackGetAddressByNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xff88c1e4.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getAddressByNumber(uint64 _lotteryNumber, uint64 _number) view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) PackGetAddressByNumber(lotteryNumber uint64, number uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("getAddressByNumber", lotteryNumber, number)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetAddressByNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xff88c1e4.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getAddressByNumber(uint64 _lotteryNumber, uint64 _number) view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackGetAddressByNumber(lotteryNumber uint64, number uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("getAddressByNumber", lotteryNumber, number)
+}
+
+// UnpackGetAddressByNumber is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xff88c1e4.
+//
+// Solidity: function getAddressByNumber(uint64 _lotteryNumber, uint64 _number) view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackGetAddressByNumber(data []byte) (common.Address, error) {
+	out, err := dailyLotteryV1.abi.Unpack("getAddressByNumber", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackGetDrawState is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x275daf01.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getDrawState(uint64 _lotteryNumber) view returns(uint8)
+func (dailyLotteryV1 *DailyLotteryV1) PackGetDrawState(lotteryNumber uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("getDrawState", lotteryNumber)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetDrawState is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x275daf01.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getDrawState(uint64 _lotteryNumber) view returns(uint8)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackGetDrawState(lotteryNumber uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("getDrawState", lotteryNumber)
+}
+
+// UnpackGetDrawState is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x275daf01.
+//
+// Solidity: function getDrawState(uint64 _lotteryNumber) view returns(uint8)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackGetDrawState(data []byte) (uint8, error) {
+	out, err := dailyLotteryV1.abi.Unpack("getDrawState", data)
+	if err != nil {
+		return *new(uint8), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+	return out0, nil
+}
+
+// PackGetDrawTime is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x21c3b840.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getDrawTime(uint64 _lotteryNumber) view returns(uint256)
+func (dailyLotteryV1 *DailyLotteryV1) PackGetDrawTime(lotteryNumber uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("getDrawTime", lotteryNumber)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetDrawTime is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x21c3b840.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getDrawTime(uint64 _lotteryNumber) view returns(uint256)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackGetDrawTime(lotteryNumber uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("getDrawTime", lotteryNumber)
+}
+
+// UnpackGetDrawTime is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x21c3b840.
+//
+// Solidity: function getDrawTime(uint64 _lotteryNumber) view returns(uint256)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackGetDrawTime(data []byte) (*big.Int, error) {
+	out, err := dailyLotteryV1.abi.Unpack("getDrawTime", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackGetFeeRate is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x23490e8a.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getFeeRate(uint64 _lotteryNumber) view returns(uint8)
+func (dailyLotteryV1 *DailyLotteryV1) PackGetFeeRate(lotteryNumber uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("getFeeRate", lotteryNumber)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetFeeRate is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x23490e8a.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getFeeRate(uint64 _lotteryNumber) view returns(uint8)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackGetFeeRate(lotteryNumber uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("getFeeRate", lotteryNumber)
+}
+
+// UnpackGetFeeRate is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x23490e8a.
+//
+// Solidity: function getFeeRate(uint64 _lotteryNumber) view returns(uint8)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackGetFeeRate(data []byte) (uint8, error) {
+	out, err := dailyLotteryV1.abi.Unpack("getFeeRate", data)
+	if err != nil {
+		return *new(uint8), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+	return out0, nil
+}
+
+// PackGetPricePerNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x57618092.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getPricePerNumber(uint64 _lotteryNumber) view returns(uint256)
+func (dailyLotteryV1 *DailyLotteryV1) PackGetPricePerNumber(lotteryNumber uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("getPricePerNumber", lotteryNumber)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetPricePerNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x57618092.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getPricePerNumber(uint64 _lotteryNumber) view returns(uint256)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackGetPricePerNumber(lotteryNumber uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("getPricePerNumber", lotteryNumber)
+}
+
+// UnpackGetPricePerNumber is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x57618092.
+//
+// Solidity: function getPricePerNumber(uint64 _lotteryNumber) view returns(uint256)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackGetPricePerNumber(data []byte) (*big.Int, error) {
+	out, err := dailyLotteryV1.abi.Unpack("getPricePerNumber", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackGetTotalAmount is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd3c56d6a.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getTotalAmount(uint64 _lotteryNumber) view returns(uint256)
+func (dailyLotteryV1 *DailyLotteryV1) PackGetTotalAmount(lotteryNumber uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("getTotalAmount", lotteryNumber)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetTotalAmount is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd3c56d6a.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getTotalAmount(uint64 _lotteryNumber) view returns(uint256)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackGetTotalAmount(lotteryNumber uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("getTotalAmount", lotteryNumber)
+}
+
+// UnpackGetTotalAmount is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xd3c56d6a.
+//
+// Solidity: function getTotalAmount(uint64 _lotteryNumber) view returns(uint256)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackGetTotalAmount(data []byte) (*big.Int, error) {
+	out, err := dailyLotteryV1.abi.Unpack("getTotalAmount", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackGetWinnerData is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x923463f5.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getWinnerData(uint64 _lotteryNumber) view returns((address,uint256,uint64,uint64))
+func (dailyLotteryV1 *DailyLotteryV1) PackGetWinnerData(lotteryNumber uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("getWinnerData", lotteryNumber)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetWinnerData is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x923463f5.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getWinnerData(uint64 _lotteryNumber) view returns((address,uint256,uint64,uint64))
+func (dailyLotteryV1 *DailyLotteryV1) TryPackGetWinnerData(lotteryNumber uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("getWinnerData", lotteryNumber)
+}
+
+// UnpackGetWinnerData is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x923463f5.
+//
+// Solidity: function getWinnerData(uint64 _lotteryNumber) view returns((address,uint256,uint64,uint64))
+func (dailyLotteryV1 *DailyLotteryV1) UnpackGetWinnerData(data []byte) (DailyLotteryV1WinnerData, error) {
+	out, err := dailyLotteryV1.abi.Unpack("getWinnerData", data)
+	if err != nil {
+		return *new(DailyLotteryV1WinnerData), err
+	}
+	out0 := *abi.ConvertType(out[0], new(DailyLotteryV1WinnerData)).(*DailyLotteryV1WinnerData)
+	return out0, nil
+}
+
+// PackInitialize is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf8c8765e.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function initialize(address _nftAddr, address _numberLogicAddr, address _randProviderAddr, address _configAddr) returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackInitialize(nftAddr common.Address, numberLogicAddr common.Address, randProviderAddr common.Address, configAddr common.Address) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("initialize", nftAddr, numberLogicAddr, randProviderAddr, configAddr)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackInitialize is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf8c8765e.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function initialize(address _nftAddr, address _numberLogicAddr, address _randProviderAddr, address _configAddr) returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackInitialize(nftAddr common.Address, numberLogicAddr common.Address, randProviderAddr common.Address, configAddr common.Address) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("initialize", nftAddr, numberLogicAddr, randProviderAddr, configAddr)
+}
+
+// PackLotteryNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xaa103e44.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function lotteryNumber() view returns(uint64)
+func (dailyLotteryV1 *DailyLotteryV1) PackLotteryNumber() []byte {
+	enc, err := dailyLotteryV1.abi.Pack("lotteryNumber")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackLotteryNumber is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xaa103e44.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function lotteryNumber() view returns(uint64)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackLotteryNumber() ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("lotteryNumber")
+}
+
+// UnpackLotteryNumber is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xaa103e44.
+//
+// Solidity: function lotteryNumber() view returns(uint64)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackLotteryNumber(data []byte) (uint64, error) {
+	out, err := dailyLotteryV1.abi.Unpack("lotteryNumber", data)
+	if err != nil {
+		return *new(uint64), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	return out0, nil
+}
+
+// PackLotterys is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x62dba35f.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function lotterys(uint64 ) view returns(uint64 lotteryNumber, uint256 pricePerNumber, uint8 feeRate, uint256 totalAmount, uint256 fee, uint256 prize, uint8 drawState, uint256 drawTime)
+func (dailyLotteryV1 *DailyLotteryV1) PackLotterys(arg0 uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("lotterys", arg0)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackLotterys is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x62dba35f.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function lotterys(uint64 ) view returns(uint64 lotteryNumber, uint256 pricePerNumber, uint8 feeRate, uint256 totalAmount, uint256 fee, uint256 prize, uint8 drawState, uint256 drawTime)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackLotterys(arg0 uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("lotterys", arg0)
+}
+
+// LotterysOutput serves as a container for the return parameters of contract
+// method Lotterys.
+type LotterysOutput struct {
+	LotteryNumber  uint64
+	PricePerNumber *big.Int
+	FeeRate        uint8
+	TotalAmount    *big.Int
+	Fee            *big.Int
+	Prize          *big.Int
+	DrawState      uint8
+	DrawTime       *big.Int
+}
+
+// UnpackLotterys is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x62dba35f.
+//
+// Solidity: function lotterys(uint64 ) view returns(uint64 lotteryNumber, uint256 pricePerNumber, uint8 feeRate, uint256 totalAmount, uint256 fee, uint256 prize, uint8 drawState, uint256 drawTime)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackLotterys(data []byte) (LotterysOutput, error) {
+	out, err := dailyLotteryV1.abi.Unpack("lotterys", data)
+	outstruct := new(LotterysOutput)
+	if err != nil {
+		return *outstruct, err
+	}
+	outstruct.LotteryNumber = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.PricePerNumber = abi.ConvertType(out[1], new(big.Int)).(*big.Int)
+	outstruct.FeeRate = *abi.ConvertType(out[2], new(uint8)).(*uint8)
+	outstruct.TotalAmount = abi.ConvertType(out[3], new(big.Int)).(*big.Int)
+	outstruct.Fee = abi.ConvertType(out[4], new(big.Int)).(*big.Int)
+	outstruct.Prize = abi.ConvertType(out[5], new(big.Int)).(*big.Int)
+	outstruct.DrawState = *abi.ConvertType(out[6], new(uint8)).(*uint8)
+	outstruct.DrawTime = abi.ConvertType(out[7], new(big.Int)).(*big.Int)
+	return *outstruct, nil
+}
+
+// PackMinDrawInterval is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x5d392fd4.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function minDrawInterval() view returns(uint64)
+func (dailyLotteryV1 *DailyLotteryV1) PackMinDrawInterval() []byte {
+	enc, err := dailyLotteryV1.abi.Pack("minDrawInterval")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackMinDrawInterval is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x5d392fd4.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function minDrawInterval() view returns(uint64)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackMinDrawInterval() ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("minDrawInterval")
+}
+
+// UnpackMinDrawInterval is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x5d392fd4.
+//
+// Solidity: function minDrawInterval() view returns(uint64)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackMinDrawInterval(data []byte) (uint64, error) {
+	out, err := dailyLotteryV1.abi.Unpack("minDrawInterval", data)
+	if err != nil {
+		return *new(uint64), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	return out0, nil
+}
+
+// PackNftContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd56d229d.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function nftContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) PackNftContract() []byte {
+	enc, err := dailyLotteryV1.abi.Pack("nftContract")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackNftContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd56d229d.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function nftContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackNftContract() ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("nftContract")
+}
+
+// UnpackNftContract is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xd56d229d.
+//
+// Solidity: function nftContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackNftContract(data []byte) (common.Address, error) {
+	out, err := dailyLotteryV1.abi.Unpack("nftContract", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackNumberLogicContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xfb343ada.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function numberLogicContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) PackNumberLogicContract() []byte {
+	enc, err := dailyLotteryV1.abi.Pack("numberLogicContract")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackNumberLogicContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xfb343ada.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function numberLogicContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackNumberLogicContract() ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("numberLogicContract")
+}
+
+// UnpackNumberLogicContract is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xfb343ada.
+//
+// Solidity: function numberLogicContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackNumberLogicContract(data []byte) (common.Address, error) {
+	out, err := dailyLotteryV1.abi.Unpack("numberLogicContract", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackNumberToUser is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe6d3b043.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function numberToUser(uint64 , uint64 ) view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) PackNumberToUser(arg0 uint64, arg1 uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("numberToUser", arg0, arg1)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackNumberToUser is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe6d3b043.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function numberToUser(uint64 , uint64 ) view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackNumberToUser(arg0 uint64, arg1 uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("numberToUser", arg0, arg1)
+}
+
+// UnpackNumberToUser is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xe6d3b043.
+//
+// Solidity: function numberToUser(uint64 , uint64 ) view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackNumberToUser(data []byte) (common.Address, error) {
+	out, err := dailyLotteryV1.abi.Unpack("numberToUser", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackOwner is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8da5cb5b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function owner() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) PackOwner() []byte {
+	enc, err := dailyLotteryV1.abi.Pack("owner")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackOwner is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8da5cb5b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function owner() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackOwner() ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("owner")
+}
+
+// UnpackOwner is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackOwner(data []byte) (common.Address, error) {
+	out, err := dailyLotteryV1.abi.Unpack("owner", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackProxiableUUID is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x52d1902d.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (dailyLotteryV1 *DailyLotteryV1) PackProxiableUUID() []byte {
+	enc, err := dailyLotteryV1.abi.Pack("proxiableUUID")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackProxiableUUID is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x52d1902d.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackProxiableUUID() ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("proxiableUUID")
+}
+
+// UnpackProxiableUUID is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackProxiableUUID(data []byte) ([32]byte, error) {
+	out, err := dailyLotteryV1.abi.Unpack("proxiableUUID", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, nil
+}
+
+// PackRandProviderContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd1f5a562.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function randProviderContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) PackRandProviderContract() []byte {
+	enc, err := dailyLotteryV1.abi.Pack("randProviderContract")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRandProviderContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd1f5a562.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function randProviderContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackRandProviderContract() ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("randProviderContract")
+}
+
+// UnpackRandProviderContract is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xd1f5a562.
+//
+// Solidity: function randProviderContract() view returns(address)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackRandProviderContract(data []byte) (common.Address, error) {
+	out, err := dailyLotteryV1.abi.Unpack("randProviderContract", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackRenounceOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x715018a6.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function renounceOwnership() returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackRenounceOwnership() []byte {
+	enc, err := dailyLotteryV1.abi.Pack("renounceOwnership")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRenounceOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x715018a6.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function renounceOwnership() returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackRenounceOwnership() ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("renounceOwnership")
+}
+
+// PackSetMinDrawInterval is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x57c6c928.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setMinDrawInterval(uint64 _minDrawInterval) returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackSetMinDrawInterval(minDrawInterval uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("setMinDrawInterval", minDrawInterval)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetMinDrawInterval is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x57c6c928.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setMinDrawInterval(uint64 _minDrawInterval) returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackSetMinDrawInterval(minDrawInterval uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("setMinDrawInterval", minDrawInterval)
+}
+
+// PackSetNftAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x0b102d1a.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setNftAddress(address _nftAddress) returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackSetNftAddress(nftAddress common.Address) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("setNftAddress", nftAddress)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetNftAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x0b102d1a.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setNftAddress(address _nftAddress) returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackSetNftAddress(nftAddress common.Address) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("setNftAddress", nftAddress)
+}
+
+// PackSetNumberLogicAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x98fa790b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setNumberLogicAddress(address _numberLogicAddress) returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackSetNumberLogicAddress(numberLogicAddress common.Address) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("setNumberLogicAddress", numberLogicAddress)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetNumberLogicAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x98fa790b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setNumberLogicAddress(address _numberLogicAddress) returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackSetNumberLogicAddress(numberLogicAddress common.Address) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("setNumberLogicAddress", numberLogicAddress)
+}
+
+// PackSetRandProviderAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3e5218fc.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setRandProviderAddress(address _randProviderAddress) returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackSetRandProviderAddress(randProviderAddress common.Address) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("setRandProviderAddress", randProviderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetRandProviderAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3e5218fc.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setRandProviderAddress(address _randProviderAddress) returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackSetRandProviderAddress(randProviderAddress common.Address) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("setRandProviderAddress", randProviderAddress)
+}
+
+// PackTakeNumbers is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x31d4ab5d.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function takeNumbers(uint64 nums) payable returns(uint64[])
+func (dailyLotteryV1 *DailyLotteryV1) PackTakeNumbers(nums uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("takeNumbers", nums)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTakeNumbers is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x31d4ab5d.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function takeNumbers(uint64 nums) payable returns(uint64[])
+func (dailyLotteryV1 *DailyLotteryV1) TryPackTakeNumbers(nums uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("takeNumbers", nums)
+}
+
+// UnpackTakeNumbers is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x31d4ab5d.
+//
+// Solidity: function takeNumbers(uint64 nums) payable returns(uint64[])
+func (dailyLotteryV1 *DailyLotteryV1) UnpackTakeNumbers(data []byte) ([]uint64, error) {
+	out, err := dailyLotteryV1.abi.Unpack("takeNumbers", data)
+	if err != nil {
+		return *new([]uint64), err
+	}
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+	return out0, nil
+}
+
+// PackTransferOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf2fde38b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackTransferOwnership(newOwner common.Address) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("transferOwnership", newOwner)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTransferOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf2fde38b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackTransferOwnership(newOwner common.Address) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("transferOwnership", newOwner)
+}
+
+// PackUpdateConfigAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xce21960c.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function updateConfigAddress(address _configAddress) returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackUpdateConfigAddress(configAddress common.Address) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("updateConfigAddress", configAddress)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackUpdateConfigAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xce21960c.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function updateConfigAddress(address _configAddress) returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackUpdateConfigAddress(configAddress common.Address) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("updateConfigAddress", configAddress)
+}
+
+// PackUpgradeToAndCall is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x4f1ef286.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (dailyLotteryV1 *DailyLotteryV1) PackUpgradeToAndCall(newImplementation common.Address, data []byte) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("upgradeToAndCall", newImplementation, data)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackUpgradeToAndCall is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x4f1ef286.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (dailyLotteryV1 *DailyLotteryV1) TryPackUpgradeToAndCall(newImplementation common.Address, data []byte) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("upgradeToAndCall", newImplementation, data)
+}
+
+// PackWinners is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x27368e73.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function winners(uint64 lotteryNumber) view returns(address winner, uint256 tokenId, uint64 number, uint64 lotteryNumber)
+func (dailyLotteryV1 *DailyLotteryV1) PackWinners(lotteryNumber uint64) []byte {
+	enc, err := dailyLotteryV1.abi.Pack("winners", lotteryNumber)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackWinners is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x27368e73.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function winners(uint64 lotteryNumber) view returns(address winner, uint256 tokenId, uint64 number, uint64 lotteryNumber)
+func (dailyLotteryV1 *DailyLotteryV1) TryPackWinners(lotteryNumber uint64) ([]byte, error) {
+	return dailyLotteryV1.abi.Pack("winners", lotteryNumber)
+}
+
+// WinnersOutput serves as a container for the return parameters of contract
+// method Winners.
+type WinnersOutput struct {
+	Winner        common.Address
+	TokenId       *big.Int
+	Number        uint64
+	LotteryNumber uint64
+}
+
+// UnpackWinners is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x27368e73.
+//
+// Solidity: function winners(uint64 lotteryNumber) view returns(address winner, uint256 tokenId, uint64 number, uint64 lotteryNumber)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackWinners(data []byte) (WinnersOutput, error) {
+	out, err := dailyLotteryV1.abi.Unpack("winners", data)
+	outstruct := new(WinnersOutput)
+	if err != nil {
+		return *outstruct, err
+	}
+	outstruct.Winner = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.TokenId = abi.ConvertType(out[1], new(big.Int)).(*big.Int)
+	outstruct.Number = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+	outstruct.LotteryNumber = *abi.ConvertType(out[3], new(uint64)).(*uint64)
+	return *outstruct, nil
+}
+
+// DailyLotteryV1Initialized represents a Initialized event raised by the DailyLotteryV1 contract.
+type DailyLotteryV1Initialized struct {
+	Version uint64
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const DailyLotteryV1InitializedEventName = "Initialized"
+
+// ContractEventName returns the user-defined event name.
+func (DailyLotteryV1Initialized) ContractEventName() string {
+	return DailyLotteryV1InitializedEventName
+}
+
+// UnpackInitializedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Initialized(uint64 version)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackInitializedEvent(log *types.Log) (*DailyLotteryV1Initialized, error) {
+	event := "Initialized"
+	if len(log.Topics) == 0 || log.Topics[0] != dailyLotteryV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(DailyLotteryV1Initialized)
+	if len(log.Data) > 0 {
+		if err := dailyLotteryV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range dailyLotteryV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// DailyLotteryV1LotteryDrawnEvent represents a LotteryDrawnEvent event raised by the DailyLotteryV1 contract.
+type DailyLotteryV1LotteryDrawnEvent struct {
+	LotteryNumber uint64
+	Winner        common.Address
+	WinnerNumber  uint64
+	Fee           *big.Int
+	Prize         *big.Int
+	DrawTime      *big.Int
+	Raw           *types.Log // Blockchain specific contextual infos
+}
+
+const DailyLotteryV1LotteryDrawnEventEventName = "LotteryDrawnEvent"
+
+// ContractEventName returns the user-defined event name.
+func (DailyLotteryV1LotteryDrawnEvent) ContractEventName() string {
+	return DailyLotteryV1LotteryDrawnEventEventName
+}
+
+// UnpackLotteryDrawnEventEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event LotteryDrawnEvent(uint64 indexed lotteryNumber, address indexed winner, uint64 winnerNumber, uint256 fee, uint256 prize, uint256 drawTime)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackLotteryDrawnEventEvent(log *types.Log) (*DailyLotteryV1LotteryDrawnEvent, error) {
+	event := "LotteryDrawnEvent"
+	if len(log.Topics) == 0 || log.Topics[0] != dailyLotteryV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(DailyLotteryV1LotteryDrawnEvent)
+	if len(log.Data) > 0 {
+		if err := dailyLotteryV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range dailyLotteryV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// DailyLotteryV1OwnershipTransferred represents a OwnershipTransferred event raised by the DailyLotteryV1 contract.
+type DailyLotteryV1OwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           *types.Log // Blockchain specific contextual infos
+}
+
+const DailyLotteryV1OwnershipTransferredEventName = "OwnershipTransferred"
+
+// ContractEventName returns the user-defined event name.
+func (DailyLotteryV1OwnershipTransferred) ContractEventName() string {
+	return DailyLotteryV1OwnershipTransferredEventName
+}
+
+// UnpackOwnershipTransferredEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackOwnershipTransferredEvent(log *types.Log) (*DailyLotteryV1OwnershipTransferred, error) {
+	event := "OwnershipTransferred"
+	if len(log.Topics) == 0 || log.Topics[0] != dailyLotteryV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(DailyLotteryV1OwnershipTransferred)
+	if len(log.Data) > 0 {
+		if err := dailyLotteryV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range dailyLotteryV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// DailyLotteryV1TakeNumbersEvent represents a TakeNumbersEvent event raised by the DailyLotteryV1 contract.
+type DailyLotteryV1TakeNumbersEvent struct {
+	LotteryNumber uint64
+	User          common.Address
+	Numbers       []uint64
+	Raw           *types.Log // Blockchain specific contextual infos
+}
+
+const DailyLotteryV1TakeNumbersEventEventName = "TakeNumbersEvent"
+
+// ContractEventName returns the user-defined event name.
+func (DailyLotteryV1TakeNumbersEvent) ContractEventName() string {
+	return DailyLotteryV1TakeNumbersEventEventName
+}
+
+// UnpackTakeNumbersEventEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event TakeNumbersEvent(uint64 indexed lotteryNumber, address indexed user, uint64[] numbers)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackTakeNumbersEventEvent(log *types.Log) (*DailyLotteryV1TakeNumbersEvent, error) {
+	event := "TakeNumbersEvent"
+	if len(log.Topics) == 0 || log.Topics[0] != dailyLotteryV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(DailyLotteryV1TakeNumbersEvent)
+	if len(log.Data) > 0 {
+		if err := dailyLotteryV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range dailyLotteryV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// DailyLotteryV1Upgraded represents a Upgraded event raised by the DailyLotteryV1 contract.
+type DailyLotteryV1Upgraded struct {
+	Implementation common.Address
+	Raw            *types.Log // Blockchain specific contextual infos
+}
+
+const DailyLotteryV1UpgradedEventName = "Upgraded"
+
+// ContractEventName returns the user-defined event name.
+func (DailyLotteryV1Upgraded) ContractEventName() string {
+	return DailyLotteryV1UpgradedEventName
+}
+
+// UnpackUpgradedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackUpgradedEvent(log *types.Log) (*DailyLotteryV1Upgraded, error) {
+	event := "Upgraded"
+	if len(log.Topics) == 0 || log.Topics[0] != dailyLotteryV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(DailyLotteryV1Upgraded)
+	if len(log.Data) > 0 {
+		if err := dailyLotteryV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range dailyLotteryV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// UnpackError attempts to decode the provided error data using user-defined
+// error definitions.
+func (dailyLotteryV1 *DailyLotteryV1) UnpackError(raw []byte) (any, error) {
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["AddressEmptyCode"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackAddressEmptyCodeError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["DrawingInProgress"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackDrawingInProgressError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["ERC1967InvalidImplementation"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackERC1967InvalidImplementationError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["ERC1967NonPayable"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackERC1967NonPayableError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["FailedCall"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackFailedCallError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["InvalidInitialization"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackInvalidInitializationError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["MinDrawIntervalNotMet"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackMinDrawIntervalNotMetError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["NoNumbersToDraw"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackNoNumbersToDrawError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["NotInitializing"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackNotInitializingError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["OnlyRandProvider"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackOnlyRandProviderError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["OwnableInvalidOwner"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackOwnableInvalidOwnerError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["OwnableUnauthorizedAccount"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackOwnableUnauthorizedAccountError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["TransferFailed"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackTransferFailedError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["UUPSUnauthorizedCallContext"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackUUPSUnauthorizedCallContextError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["UUPSUnsupportedProxiableUUID"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackUUPSUnsupportedProxiableUUIDError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["WrongEthValue"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackWrongEthValueError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryV1.abi.Errors["WrongLotteryNumber"].ID.Bytes()[:4]) {
+		return dailyLotteryV1.UnpackWrongLotteryNumberError(raw[4:])
+	}
+	return nil, errors.New("Unknown error")
+}
+
+// DailyLotteryV1AddressEmptyCode represents a AddressEmptyCode error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1AddressEmptyCode struct {
+	Target common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error AddressEmptyCode(address target)
+func DailyLotteryV1AddressEmptyCodeErrorID() common.Hash {
+	return common.HexToHash("0x9996b315c842ff135b8fc4a08ad5df1c344efbc03d2687aecc0678050d2aac89")
+}
+
+// UnpackAddressEmptyCodeError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error AddressEmptyCode(address target)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackAddressEmptyCodeError(raw []byte) (*DailyLotteryV1AddressEmptyCode, error) {
+	out := new(DailyLotteryV1AddressEmptyCode)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "AddressEmptyCode", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1DrawingInProgress represents a DrawingInProgress error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1DrawingInProgress struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error DrawingInProgress()
+func DailyLotteryV1DrawingInProgressErrorID() common.Hash {
+	return common.HexToHash("0x4674a263e87ac5c7a44320b45575418babd1b52e9224f4dbbcc0f09cf73f24df")
+}
+
+// UnpackDrawingInProgressError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error DrawingInProgress()
+func (dailyLotteryV1 *DailyLotteryV1) UnpackDrawingInProgressError(raw []byte) (*DailyLotteryV1DrawingInProgress, error) {
+	out := new(DailyLotteryV1DrawingInProgress)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "DrawingInProgress", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1ERC1967InvalidImplementation represents a ERC1967InvalidImplementation error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1ERC1967InvalidImplementation struct {
+	Implementation common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC1967InvalidImplementation(address implementation)
+func DailyLotteryV1ERC1967InvalidImplementationErrorID() common.Hash {
+	return common.HexToHash("0x4c9c8ce3ceb3130f17f7cdba48d89b5b0129f266a8bac114e6e315a41879b617")
+}
+
+// UnpackERC1967InvalidImplementationError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC1967InvalidImplementation(address implementation)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackERC1967InvalidImplementationError(raw []byte) (*DailyLotteryV1ERC1967InvalidImplementation, error) {
+	out := new(DailyLotteryV1ERC1967InvalidImplementation)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "ERC1967InvalidImplementation", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1ERC1967NonPayable represents a ERC1967NonPayable error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1ERC1967NonPayable struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC1967NonPayable()
+func DailyLotteryV1ERC1967NonPayableErrorID() common.Hash {
+	return common.HexToHash("0xb398979fa84f543c8e222f17890372c487baf85e062276c127fef521eea7224b")
+}
+
+// UnpackERC1967NonPayableError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC1967NonPayable()
+func (dailyLotteryV1 *DailyLotteryV1) UnpackERC1967NonPayableError(raw []byte) (*DailyLotteryV1ERC1967NonPayable, error) {
+	out := new(DailyLotteryV1ERC1967NonPayable)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "ERC1967NonPayable", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1FailedCall represents a FailedCall error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1FailedCall struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error FailedCall()
+func DailyLotteryV1FailedCallErrorID() common.Hash {
+	return common.HexToHash("0xd6bda27508c0fb6d8a39b4b122878dab26f731a7d4e4abe711dd3731899052a4")
+}
+
+// UnpackFailedCallError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error FailedCall()
+func (dailyLotteryV1 *DailyLotteryV1) UnpackFailedCallError(raw []byte) (*DailyLotteryV1FailedCall, error) {
+	out := new(DailyLotteryV1FailedCall)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "FailedCall", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1InvalidInitialization represents a InvalidInitialization error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1InvalidInitialization struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error InvalidInitialization()
+func DailyLotteryV1InvalidInitializationErrorID() common.Hash {
+	return common.HexToHash("0xf92ee8a957075833165f68c320933b1a1294aafc84ee6e0dd3fb178008f9aaf5")
+}
+
+// UnpackInvalidInitializationError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error InvalidInitialization()
+func (dailyLotteryV1 *DailyLotteryV1) UnpackInvalidInitializationError(raw []byte) (*DailyLotteryV1InvalidInitialization, error) {
+	out := new(DailyLotteryV1InvalidInitialization)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "InvalidInitialization", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1MinDrawIntervalNotMet represents a MinDrawIntervalNotMet error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1MinDrawIntervalNotMet struct {
+	StartTime   *big.Int
+	CurrentTime *big.Int
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error MinDrawIntervalNotMet(uint256 startTime, uint256 currentTime)
+func DailyLotteryV1MinDrawIntervalNotMetErrorID() common.Hash {
+	return common.HexToHash("0x629f7f15ed04ec43ba834bce6fb8a8ac3b2fa5ffdf8bb06728cdeca96912642e")
+}
+
+// UnpackMinDrawIntervalNotMetError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error MinDrawIntervalNotMet(uint256 startTime, uint256 currentTime)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackMinDrawIntervalNotMetError(raw []byte) (*DailyLotteryV1MinDrawIntervalNotMet, error) {
+	out := new(DailyLotteryV1MinDrawIntervalNotMet)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "MinDrawIntervalNotMet", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1NoNumbersToDraw represents a NoNumbersToDraw error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1NoNumbersToDraw struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error NoNumbersToDraw()
+func DailyLotteryV1NoNumbersToDrawErrorID() common.Hash {
+	return common.HexToHash("0xe6e8a986e66d0d6782174c271e8ebfc28e745edcadacaf7326b7bcb85699f70c")
+}
+
+// UnpackNoNumbersToDrawError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error NoNumbersToDraw()
+func (dailyLotteryV1 *DailyLotteryV1) UnpackNoNumbersToDrawError(raw []byte) (*DailyLotteryV1NoNumbersToDraw, error) {
+	out := new(DailyLotteryV1NoNumbersToDraw)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "NoNumbersToDraw", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1NotInitializing represents a NotInitializing error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1NotInitializing struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error NotInitializing()
+func DailyLotteryV1NotInitializingErrorID() common.Hash {
+	return common.HexToHash("0xd7e6bcf8597daa127dc9f0048d2f08d5ef140a2cb659feabd700beff1f7a8302")
+}
+
+// UnpackNotInitializingError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error NotInitializing()
+func (dailyLotteryV1 *DailyLotteryV1) UnpackNotInitializingError(raw []byte) (*DailyLotteryV1NotInitializing, error) {
+	out := new(DailyLotteryV1NotInitializing)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "NotInitializing", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1OnlyRandProvider represents a OnlyRandProvider error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1OnlyRandProvider struct {
+	Sender common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OnlyRandProvider(address sender)
+func DailyLotteryV1OnlyRandProviderErrorID() common.Hash {
+	return common.HexToHash("0x3349fd1fb14d508148b8931b3404e8787c73ff82c861755dca6ef84abbd2f047")
+}
+
+// UnpackOnlyRandProviderError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OnlyRandProvider(address sender)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackOnlyRandProviderError(raw []byte) (*DailyLotteryV1OnlyRandProvider, error) {
+	out := new(DailyLotteryV1OnlyRandProvider)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "OnlyRandProvider", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1OwnableInvalidOwner represents a OwnableInvalidOwner error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1OwnableInvalidOwner struct {
+	Owner common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OwnableInvalidOwner(address owner)
+func DailyLotteryV1OwnableInvalidOwnerErrorID() common.Hash {
+	return common.HexToHash("0x1e4fbdf7f3ef8bcaa855599e3abf48b232380f183f08f6f813d9ffa5bd585188")
+}
+
+// UnpackOwnableInvalidOwnerError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OwnableInvalidOwner(address owner)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackOwnableInvalidOwnerError(raw []byte) (*DailyLotteryV1OwnableInvalidOwner, error) {
+	out := new(DailyLotteryV1OwnableInvalidOwner)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "OwnableInvalidOwner", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1OwnableUnauthorizedAccount represents a OwnableUnauthorizedAccount error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1OwnableUnauthorizedAccount struct {
+	Account common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OwnableUnauthorizedAccount(address account)
+func DailyLotteryV1OwnableUnauthorizedAccountErrorID() common.Hash {
+	return common.HexToHash("0x118cdaa7a341953d1887a2245fd6665d741c67c8c50581daa59e1d03373fa188")
+}
+
+// UnpackOwnableUnauthorizedAccountError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OwnableUnauthorizedAccount(address account)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackOwnableUnauthorizedAccountError(raw []byte) (*DailyLotteryV1OwnableUnauthorizedAccount, error) {
+	out := new(DailyLotteryV1OwnableUnauthorizedAccount)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "OwnableUnauthorizedAccount", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1TransferFailed represents a TransferFailed error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1TransferFailed struct {
+	Value *big.Int
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error TransferFailed(uint256 value)
+func DailyLotteryV1TransferFailedErrorID() common.Hash {
+	return common.HexToHash("0xc39ba1a9a812115efec13c9546b7b109574823d9c12ff09ebcc4ba5d47153ec6")
+}
+
+// UnpackTransferFailedError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error TransferFailed(uint256 value)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackTransferFailedError(raw []byte) (*DailyLotteryV1TransferFailed, error) {
+	out := new(DailyLotteryV1TransferFailed)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "TransferFailed", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1UUPSUnauthorizedCallContext represents a UUPSUnauthorizedCallContext error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1UUPSUnauthorizedCallContext struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error UUPSUnauthorizedCallContext()
+func DailyLotteryV1UUPSUnauthorizedCallContextErrorID() common.Hash {
+	return common.HexToHash("0xe07c8dba242a06571ac65fe4bbe20522c9fb111cb33599b799ff8039c1ed18f4")
+}
+
+// UnpackUUPSUnauthorizedCallContextError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error UUPSUnauthorizedCallContext()
+func (dailyLotteryV1 *DailyLotteryV1) UnpackUUPSUnauthorizedCallContextError(raw []byte) (*DailyLotteryV1UUPSUnauthorizedCallContext, error) {
+	out := new(DailyLotteryV1UUPSUnauthorizedCallContext)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "UUPSUnauthorizedCallContext", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1UUPSUnsupportedProxiableUUID represents a UUPSUnsupportedProxiableUUID error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1UUPSUnsupportedProxiableUUID struct {
+	Slot [32]byte
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error UUPSUnsupportedProxiableUUID(bytes32 slot)
+func DailyLotteryV1UUPSUnsupportedProxiableUUIDErrorID() common.Hash {
+	return common.HexToHash("0xaa1d49a4c084bfa9aeeee2a0be65267a7f19ba7e1476b114dac513d2c14cb563")
+}
+
+// UnpackUUPSUnsupportedProxiableUUIDError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error UUPSUnsupportedProxiableUUID(bytes32 slot)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackUUPSUnsupportedProxiableUUIDError(raw []byte) (*DailyLotteryV1UUPSUnsupportedProxiableUUID, error) {
+	out := new(DailyLotteryV1UUPSUnsupportedProxiableUUID)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "UUPSUnsupportedProxiableUUID", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1WrongEthValue represents a WrongEthValue error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1WrongEthValue struct {
+	Value *big.Int
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error WrongEthValue(uint256 value)
+func DailyLotteryV1WrongEthValueErrorID() common.Hash {
+	return common.HexToHash("0xbbf69f356193b64c3b03b1b2d4d341b8c5400f342f589b59867d5ea7104a75e3")
+}
+
+// UnpackWrongEthValueError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error WrongEthValue(uint256 value)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackWrongEthValueError(raw []byte) (*DailyLotteryV1WrongEthValue, error) {
+	out := new(DailyLotteryV1WrongEthValue)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "WrongEthValue", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryV1WrongLotteryNumber represents a WrongLotteryNumber error raised by the DailyLotteryV1 contract.
+type DailyLotteryV1WrongLotteryNumber struct {
+	Param   uint64
+	Current uint64
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error WrongLotteryNumber(uint64 param, uint64 current)
+func DailyLotteryV1WrongLotteryNumberErrorID() common.Hash {
+	return common.HexToHash("0x00b1a2e5c37cc44b7acc073fa1babd1816074fcd10a8964bb38902d05bbcf0c7")
+}
+
+// UnpackWrongLotteryNumberError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error WrongLotteryNumber(uint64 param, uint64 current)
+func (dailyLotteryV1 *DailyLotteryV1) UnpackWrongLotteryNumberError(raw []byte) (*DailyLotteryV1WrongLotteryNumber, error) {
+	out := new(DailyLotteryV1WrongLotteryNumber)
+	if err := dailyLotteryV1.abi.UnpackIntoInterface(out, "WrongLotteryNumber", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
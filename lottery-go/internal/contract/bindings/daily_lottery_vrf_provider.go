@@ -0,0 +1,829 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// DailyLotteryVRFProviderMetaData contains all meta data concerning the DailyLotteryVRFProvider contract.
+var DailyLotteryVRFProviderMetaData = bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"vrfCoordinator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_subId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_keyHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"acceptOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"callback\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIDailyLotteryRandCallback\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"callbackGasLimit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"keyHash\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"rawFulfillRandomWords\",\"inputs\":[{\"name\":\"requestId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"randomWords\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"requestConfirmations\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"requestRandomNumbers\",\"inputs\":[{\"name\":\"nums\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"s_vrfCoordinator\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIVRFCoordinatorV2Plus\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setCallbackAddress\",\"inputs\":[{\"name\":\"_callbackAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setCallbackGasLimit\",\"inputs\":[{\"name\":\"_callbackGasLimit\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setCoordinator\",\"inputs\":[{\"name\":\"_vrfCoordinator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setKeyHash\",\"inputs\":[{\"name\":\"_keyHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setRequestConfirmations\",\"inputs\":[{\"name\":\"_requestConfirmations\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setSubId\",\"inputs\":[{\"name\":\"_subId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"subId\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"vrfRequestId\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"CoordinatorSet\",\"inputs\":[{\"name\":\"vrfCoordinator\",\"type\":\"address\",\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferRequested\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"OnlyCoordinatorCanFulfill\",\"inputs\":[{\"name\":\"have\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"want\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OnlyOwnerOrCoordinator\",\"inputs\":[{\"name\":\"have\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"coordinator\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"VRFRequestAlreadyRequested\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VRFRequestFailed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZeroAddress\",\"inputs\":[]}]",
+	ID:  "DailyLotteryVRFProvider",
+}
+
+// DailyLotteryVRFProvider is an auto generated Go binding around an Ethereum contract.
+type DailyLotteryVRFProvider struct {
+	abi abi.ABI
+}
+
+// NewDailyLotteryVRFProvider creates a new instance of DailyLotteryVRFProvider.
+func NewDailyLotteryVRFProvider() *DailyLotteryVRFProvider {
+	parsed, err := DailyLotteryVRFProviderMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &DailyLotteryVRFProvider{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *DailyLotteryVRFProvider) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackConstructor is the Go binding used to pack the parameters required for
+// contract deployment.
+//
+// Solidity: constructor(address vrfCoordinator, uint256 _subId, bytes32 _keyHash) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackConstructor(vrfCoordinator common.Address, _subId *big.Int, _keyHash [32]byte) []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("", vrfCoordinator, _subId, _keyHash)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackAcceptOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x79ba5097.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function acceptOwnership() returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackAcceptOwnership() []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("acceptOwnership")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackAcceptOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x79ba5097.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function acceptOwnership() returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackAcceptOwnership() ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("acceptOwnership")
+}
+
+// PackCallback is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x083b2732.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function callback() view returns(address)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackCallback() []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("callback")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackCallback is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x083b2732.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function callback() view returns(address)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackCallback() ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("callback")
+}
+
+// UnpackCallback is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x083b2732.
+//
+// Solidity: function callback() view returns(address)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackCallback(data []byte) (common.Address, error) {
+	out, err := dailyLotteryVRFProvider.abi.Unpack("callback", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackCallbackGasLimit is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x24f74697.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function callbackGasLimit() view returns(uint32)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackCallbackGasLimit() []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("callbackGasLimit")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackCallbackGasLimit is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x24f74697.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function callbackGasLimit() view returns(uint32)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackCallbackGasLimit() ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("callbackGasLimit")
+}
+
+// UnpackCallbackGasLimit is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x24f74697.
+//
+// Solidity: function callbackGasLimit() view returns(uint32)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackCallbackGasLimit(data []byte) (uint32, error) {
+	out, err := dailyLotteryVRFProvider.abi.Unpack("callbackGasLimit", data)
+	if err != nil {
+		return *new(uint32), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+	return out0, nil
+}
+
+// PackKeyHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x61728f39.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function keyHash() view returns(bytes32)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackKeyHash() []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("keyHash")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackKeyHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x61728f39.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function keyHash() view returns(bytes32)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackKeyHash() ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("keyHash")
+}
+
+// UnpackKeyHash is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x61728f39.
+//
+// Solidity: function keyHash() view returns(bytes32)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackKeyHash(data []byte) ([32]byte, error) {
+	out, err := dailyLotteryVRFProvider.abi.Unpack("keyHash", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, nil
+}
+
+// PackOwner is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8da5cb5b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function owner() view returns(address)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackOwner() []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("owner")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackOwner is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8da5cb5b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function owner() view returns(address)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackOwner() ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("owner")
+}
+
+// UnpackOwner is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackOwner(data []byte) (common.Address, error) {
+	out, err := dailyLotteryVRFProvider.abi.Unpack("owner", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackRawFulfillRandomWords is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x1fe543e3.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function rawFulfillRandomWords(uint256 requestId, uint256[] randomWords) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackRawFulfillRandomWords(requestId *big.Int, randomWords []*big.Int) []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("rawFulfillRandomWords", requestId, randomWords)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRawFulfillRandomWords is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x1fe543e3.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function rawFulfillRandomWords(uint256 requestId, uint256[] randomWords) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackRawFulfillRandomWords(requestId *big.Int, randomWords []*big.Int) ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("rawFulfillRandomWords", requestId, randomWords)
+}
+
+// PackRequestConfirmations is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb0fb162f.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function requestConfirmations() view returns(uint16)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackRequestConfirmations() []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("requestConfirmations")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRequestConfirmations is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb0fb162f.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function requestConfirmations() view returns(uint16)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackRequestConfirmations() ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("requestConfirmations")
+}
+
+// UnpackRequestConfirmations is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xb0fb162f.
+//
+// Solidity: function requestConfirmations() view returns(uint16)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackRequestConfirmations(data []byte) (uint16, error) {
+	out, err := dailyLotteryVRFProvider.abi.Unpack("requestConfirmations", data)
+	if err != nil {
+		return *new(uint16), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+	return out0, nil
+}
+
+// PackRequestRandomNumbers is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x7705a7f6.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function requestRandomNumbers(uint32 nums) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackRequestRandomNumbers(nums uint32) []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("requestRandomNumbers", nums)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRequestRandomNumbers is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x7705a7f6.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function requestRandomNumbers(uint32 nums) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackRequestRandomNumbers(nums uint32) ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("requestRandomNumbers", nums)
+}
+
+// PackSVrfCoordinator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x9eccacf6.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function s_vrfCoordinator() view returns(address)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackSVrfCoordinator() []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("s_vrfCoordinator")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSVrfCoordinator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x9eccacf6.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function s_vrfCoordinator() view returns(address)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackSVrfCoordinator() ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("s_vrfCoordinator")
+}
+
+// UnpackSVrfCoordinator is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x9eccacf6.
+//
+// Solidity: function s_vrfCoordinator() view returns(address)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackSVrfCoordinator(data []byte) (common.Address, error) {
+	out, err := dailyLotteryVRFProvider.abi.Unpack("s_vrfCoordinator", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackSetCallbackAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x37881810.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setCallbackAddress(address _callbackAddress) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackSetCallbackAddress(callbackAddress common.Address) []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("setCallbackAddress", callbackAddress)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetCallbackAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x37881810.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setCallbackAddress(address _callbackAddress) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackSetCallbackAddress(callbackAddress common.Address) ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("setCallbackAddress", callbackAddress)
+}
+
+// PackSetCallbackGasLimit is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa4eb718c.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setCallbackGasLimit(uint32 _callbackGasLimit) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackSetCallbackGasLimit(callbackGasLimit uint32) []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("setCallbackGasLimit", callbackGasLimit)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetCallbackGasLimit is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa4eb718c.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setCallbackGasLimit(uint32 _callbackGasLimit) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackSetCallbackGasLimit(callbackGasLimit uint32) ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("setCallbackGasLimit", callbackGasLimit)
+}
+
+// PackSetCoordinator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8ea98117.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setCoordinator(address _vrfCoordinator) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackSetCoordinator(vrfCoordinator common.Address) []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("setCoordinator", vrfCoordinator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetCoordinator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8ea98117.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setCoordinator(address _vrfCoordinator) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackSetCoordinator(vrfCoordinator common.Address) ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("setCoordinator", vrfCoordinator)
+}
+
+// PackSetKeyHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x98544710.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setKeyHash(bytes32 _keyHash) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackSetKeyHash(keyHash [32]byte) []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("setKeyHash", keyHash)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetKeyHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x98544710.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setKeyHash(bytes32 _keyHash) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackSetKeyHash(keyHash [32]byte) ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("setKeyHash", keyHash)
+}
+
+// PackSetRequestConfirmations is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8824f5a7.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setRequestConfirmations(uint16 _requestConfirmations) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackSetRequestConfirmations(requestConfirmations uint16) []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("setRequestConfirmations", requestConfirmations)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetRequestConfirmations is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8824f5a7.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setRequestConfirmations(uint16 _requestConfirmations) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackSetRequestConfirmations(requestConfirmations uint16) ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("setRequestConfirmations", requestConfirmations)
+}
+
+// PackSetSubId is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x80980043.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setSubId(uint256 _subId) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackSetSubId(subId *big.Int) []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("setSubId", subId)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetSubId is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x80980043.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setSubId(uint256 _subId) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackSetSubId(subId *big.Int) ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("setSubId", subId)
+}
+
+// PackSubId is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xeb1d28bb.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function subId() view returns(uint256)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackSubId() []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("subId")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSubId is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xeb1d28bb.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function subId() view returns(uint256)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackSubId() ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("subId")
+}
+
+// UnpackSubId is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xeb1d28bb.
+//
+// Solidity: function subId() view returns(uint256)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackSubId(data []byte) (*big.Int, error) {
+	out, err := dailyLotteryVRFProvider.abi.Unpack("subId", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackTransferOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf2fde38b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function transferOwnership(address to) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackTransferOwnership(to common.Address) []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("transferOwnership", to)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTransferOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf2fde38b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function transferOwnership(address to) returns()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackTransferOwnership(to common.Address) ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("transferOwnership", to)
+}
+
+// PackVrfRequestId is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x18a7ea5f.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function vrfRequestId() view returns(uint256)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) PackVrfRequestId() []byte {
+	enc, err := dailyLotteryVRFProvider.abi.Pack("vrfRequestId")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackVrfRequestId is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x18a7ea5f.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function vrfRequestId() view returns(uint256)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) TryPackVrfRequestId() ([]byte, error) {
+	return dailyLotteryVRFProvider.abi.Pack("vrfRequestId")
+}
+
+// UnpackVrfRequestId is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x18a7ea5f.
+//
+// Solidity: function vrfRequestId() view returns(uint256)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackVrfRequestId(data []byte) (*big.Int, error) {
+	out, err := dailyLotteryVRFProvider.abi.Unpack("vrfRequestId", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// DailyLotteryVRFProviderCoordinatorSet represents a CoordinatorSet event raised by the DailyLotteryVRFProvider contract.
+type DailyLotteryVRFProviderCoordinatorSet struct {
+	VrfCoordinator common.Address
+	Raw            *types.Log // Blockchain specific contextual infos
+}
+
+const DailyLotteryVRFProviderCoordinatorSetEventName = "CoordinatorSet"
+
+// ContractEventName returns the user-defined event name.
+func (DailyLotteryVRFProviderCoordinatorSet) ContractEventName() string {
+	return DailyLotteryVRFProviderCoordinatorSetEventName
+}
+
+// UnpackCoordinatorSetEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event CoordinatorSet(address vrfCoordinator)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackCoordinatorSetEvent(log *types.Log) (*DailyLotteryVRFProviderCoordinatorSet, error) {
+	event := "CoordinatorSet"
+	if len(log.Topics) == 0 || log.Topics[0] != dailyLotteryVRFProvider.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(DailyLotteryVRFProviderCoordinatorSet)
+	if len(log.Data) > 0 {
+		if err := dailyLotteryVRFProvider.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range dailyLotteryVRFProvider.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// DailyLotteryVRFProviderOwnershipTransferRequested represents a OwnershipTransferRequested event raised by the DailyLotteryVRFProvider contract.
+type DailyLotteryVRFProviderOwnershipTransferRequested struct {
+	From common.Address
+	To   common.Address
+	Raw  *types.Log // Blockchain specific contextual infos
+}
+
+const DailyLotteryVRFProviderOwnershipTransferRequestedEventName = "OwnershipTransferRequested"
+
+// ContractEventName returns the user-defined event name.
+func (DailyLotteryVRFProviderOwnershipTransferRequested) ContractEventName() string {
+	return DailyLotteryVRFProviderOwnershipTransferRequestedEventName
+}
+
+// UnpackOwnershipTransferRequestedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event OwnershipTransferRequested(address indexed from, address indexed to)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackOwnershipTransferRequestedEvent(log *types.Log) (*DailyLotteryVRFProviderOwnershipTransferRequested, error) {
+	event := "OwnershipTransferRequested"
+	if len(log.Topics) == 0 || log.Topics[0] != dailyLotteryVRFProvider.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(DailyLotteryVRFProviderOwnershipTransferRequested)
+	if len(log.Data) > 0 {
+		if err := dailyLotteryVRFProvider.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range dailyLotteryVRFProvider.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// DailyLotteryVRFProviderOwnershipTransferred represents a OwnershipTransferred event raised by the DailyLotteryVRFProvider contract.
+type DailyLotteryVRFProviderOwnershipTransferred struct {
+	From common.Address
+	To   common.Address
+	Raw  *types.Log // Blockchain specific contextual infos
+}
+
+const DailyLotteryVRFProviderOwnershipTransferredEventName = "OwnershipTransferred"
+
+// ContractEventName returns the user-defined event name.
+func (DailyLotteryVRFProviderOwnershipTransferred) ContractEventName() string {
+	return DailyLotteryVRFProviderOwnershipTransferredEventName
+}
+
+// UnpackOwnershipTransferredEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event OwnershipTransferred(address indexed from, address indexed to)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackOwnershipTransferredEvent(log *types.Log) (*DailyLotteryVRFProviderOwnershipTransferred, error) {
+	event := "OwnershipTransferred"
+	if len(log.Topics) == 0 || log.Topics[0] != dailyLotteryVRFProvider.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(DailyLotteryVRFProviderOwnershipTransferred)
+	if len(log.Data) > 0 {
+		if err := dailyLotteryVRFProvider.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range dailyLotteryVRFProvider.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// UnpackError attempts to decode the provided error data using user-defined
+// error definitions.
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackError(raw []byte) (any, error) {
+	if bytes.Equal(raw[:4], dailyLotteryVRFProvider.abi.Errors["OnlyCoordinatorCanFulfill"].ID.Bytes()[:4]) {
+		return dailyLotteryVRFProvider.UnpackOnlyCoordinatorCanFulfillError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryVRFProvider.abi.Errors["OnlyOwnerOrCoordinator"].ID.Bytes()[:4]) {
+		return dailyLotteryVRFProvider.UnpackOnlyOwnerOrCoordinatorError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryVRFProvider.abi.Errors["VRFRequestAlreadyRequested"].ID.Bytes()[:4]) {
+		return dailyLotteryVRFProvider.UnpackVRFRequestAlreadyRequestedError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryVRFProvider.abi.Errors["VRFRequestFailed"].ID.Bytes()[:4]) {
+		return dailyLotteryVRFProvider.UnpackVRFRequestFailedError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], dailyLotteryVRFProvider.abi.Errors["ZeroAddress"].ID.Bytes()[:4]) {
+		return dailyLotteryVRFProvider.UnpackZeroAddressError(raw[4:])
+	}
+	return nil, errors.New("Unknown error")
+}
+
+// DailyLotteryVRFProviderOnlyCoordinatorCanFulfill represents a OnlyCoordinatorCanFulfill error raised by the DailyLotteryVRFProvider contract.
+type DailyLotteryVRFProviderOnlyCoordinatorCanFulfill struct {
+	Have common.Address
+	Want common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OnlyCoordinatorCanFulfill(address have, address want)
+func DailyLotteryVRFProviderOnlyCoordinatorCanFulfillErrorID() common.Hash {
+	return common.HexToHash("0x1cf993f4855ca2a758bea0e0e264b2cd16369bc2acc356b4ef6323767def33d8")
+}
+
+// UnpackOnlyCoordinatorCanFulfillError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OnlyCoordinatorCanFulfill(address have, address want)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackOnlyCoordinatorCanFulfillError(raw []byte) (*DailyLotteryVRFProviderOnlyCoordinatorCanFulfill, error) {
+	out := new(DailyLotteryVRFProviderOnlyCoordinatorCanFulfill)
+	if err := dailyLotteryVRFProvider.abi.UnpackIntoInterface(out, "OnlyCoordinatorCanFulfill", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryVRFProviderOnlyOwnerOrCoordinator represents a OnlyOwnerOrCoordinator error raised by the DailyLotteryVRFProvider contract.
+type DailyLotteryVRFProviderOnlyOwnerOrCoordinator struct {
+	Have        common.Address
+	Owner       common.Address
+	Coordinator common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OnlyOwnerOrCoordinator(address have, address owner, address coordinator)
+func DailyLotteryVRFProviderOnlyOwnerOrCoordinatorErrorID() common.Hash {
+	return common.HexToHash("0x061db9c18b2171ebdcc49c56bb45998700c8e30d0757c65e99e8734441cf0174")
+}
+
+// UnpackOnlyOwnerOrCoordinatorError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OnlyOwnerOrCoordinator(address have, address owner, address coordinator)
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackOnlyOwnerOrCoordinatorError(raw []byte) (*DailyLotteryVRFProviderOnlyOwnerOrCoordinator, error) {
+	out := new(DailyLotteryVRFProviderOnlyOwnerOrCoordinator)
+	if err := dailyLotteryVRFProvider.abi.UnpackIntoInterface(out, "OnlyOwnerOrCoordinator", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryVRFProviderVRFRequestAlreadyRequested represents a VRFRequestAlreadyRequested error raised by the DailyLotteryVRFProvider contract.
+type DailyLotteryVRFProviderVRFRequestAlreadyRequested struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error VRFRequestAlreadyRequested()
+func DailyLotteryVRFProviderVRFRequestAlreadyRequestedErrorID() common.Hash {
+	return common.HexToHash("0x91128304c88e12d84ecdd50c1829bca76742135aa4bfc597c6066573a5ddb23a")
+}
+
+// UnpackVRFRequestAlreadyRequestedError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error VRFRequestAlreadyRequested()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackVRFRequestAlreadyRequestedError(raw []byte) (*DailyLotteryVRFProviderVRFRequestAlreadyRequested, error) {
+	out := new(DailyLotteryVRFProviderVRFRequestAlreadyRequested)
+	if err := dailyLotteryVRFProvider.abi.UnpackIntoInterface(out, "VRFRequestAlreadyRequested", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryVRFProviderVRFRequestFailed represents a VRFRequestFailed error raised by the DailyLotteryVRFProvider contract.
+type DailyLotteryVRFProviderVRFRequestFailed struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error VRFRequestFailed()
+func DailyLotteryVRFProviderVRFRequestFailedErrorID() common.Hash {
+	return common.HexToHash("0x884a9c8b3707ab279207b490488355e6535079d6b4519ad8f5405924c617c926")
+}
+
+// UnpackVRFRequestFailedError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error VRFRequestFailed()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackVRFRequestFailedError(raw []byte) (*DailyLotteryVRFProviderVRFRequestFailed, error) {
+	out := new(DailyLotteryVRFProviderVRFRequestFailed)
+	if err := dailyLotteryVRFProvider.abi.UnpackIntoInterface(out, "VRFRequestFailed", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyLotteryVRFProviderZeroAddress represents a ZeroAddress error raised by the DailyLotteryVRFProvider contract.
+type DailyLotteryVRFProviderZeroAddress struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ZeroAddress()
+func DailyLotteryVRFProviderZeroAddressErrorID() common.Hash {
+	return common.HexToHash("0xd92e233df2717d4a40030e20904abd27b68fcbeede117eaaccbbdac9618c8c73")
+}
+
+// UnpackZeroAddressError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ZeroAddress()
+func (dailyLotteryVRFProvider *DailyLotteryVRFProvider) UnpackZeroAddressError(raw []byte) (*DailyLotteryVRFProviderZeroAddress, error) {
+	out := new(DailyLotteryVRFProviderZeroAddress)
+	if err := dailyLotteryVRFProvider.abi.UnpackIntoInterface(out, "ZeroAddress", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
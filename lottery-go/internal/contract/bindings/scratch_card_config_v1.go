@@ -0,0 +1,121 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// ScratchCardConfigV1MetaData contains all meta data concerning the ScratchCardConfigV1 contract.
+var ScratchCardConfigV1MetaData = bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"FeeRate\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"Price\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"}]",
+	ID:  "ScratchCardConfigV1",
+}
+
+// ScratchCardConfigV1 is an auto generated Go binding around an Ethereum contract.
+type ScratchCardConfigV1 struct {
+	abi abi.ABI
+}
+
+// NewScratchCardConfigV1 creates a new instance of ScratchCardConfigV1.
+func NewScratchCardConfigV1() *ScratchCardConfigV1 {
+	parsed, err := ScratchCardConfigV1MetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &ScratchCardConfigV1{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *ScratchCardConfigV1) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackFeeRate is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xabe2a16e.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function FeeRate() pure returns(uint8)
+func (scratchCardConfigV1 *ScratchCardConfigV1) PackFeeRate() []byte {
+	enc, err := scratchCardConfigV1.abi.Pack("FeeRate")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackFeeRate is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xabe2a16e.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function FeeRate() pure returns(uint8)
+func (scratchCardConfigV1 *ScratchCardConfigV1) TryPackFeeRate() ([]byte, error) {
+	return scratchCardConfigV1.abi.Pack("FeeRate")
+}
+
+// UnpackFeeRate is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xabe2a16e.
+//
+// Solidity: function FeeRate() pure returns(uint8)
+func (scratchCardConfigV1 *ScratchCardConfigV1) UnpackFeeRate(data []byte) (uint8, error) {
+	out, err := scratchCardConfigV1.abi.Unpack("FeeRate", data)
+	if err != nil {
+		return *new(uint8), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+	return out0, nil
+}
+
+// PackPrice is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x9dfde201.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function Price() pure returns(uint256)
+func (scratchCardConfigV1 *ScratchCardConfigV1) PackPrice() []byte {
+	enc, err := scratchCardConfigV1.abi.Pack("Price")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackPrice is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x9dfde201.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function Price() pure returns(uint256)
+func (scratchCardConfigV1 *ScratchCardConfigV1) TryPackPrice() ([]byte, error) {
+	return scratchCardConfigV1.abi.Pack("Price")
+}
+
+// UnpackPrice is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x9dfde201.
+//
+// Solidity: function Price() pure returns(uint256)
+func (scratchCardConfigV1 *ScratchCardConfigV1) UnpackPrice(data []byte) (*big.Int, error) {
+	out, err := scratchCardConfigV1.abi.Unpack("Price", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
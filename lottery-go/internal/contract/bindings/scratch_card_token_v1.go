@@ -0,0 +1,1437 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// ScratchCardTokenV1MetaData contains all meta data concerning the ScratchCardTokenV1 contract.
+var ScratchCardTokenV1MetaData = bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"approve\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getApproved\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isApprovedForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"ownerOf\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"prizeTokenUris\",\"inputs\":[{\"name\":\"prize\",\"type\":\"uint8\",\"internalType\":\"enumScratchCardPrize\"}],\"outputs\":[{\"name\":\"tokenUri\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeMint\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"prize\",\"type\":\"uint8\",\"internalType\":\"enumScratchCardPrize\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setAllowedMinter\",\"inputs\":[{\"name\":\"minter\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setApprovalForAll\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"symbol\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenURI\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Approval\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ApprovalForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchMetadataUpdate\",\"inputs\":[{\"name\":\"_fromTokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_toTokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MetadataUpdate\",\"inputs\":[{\"name\":\"_tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ERC721IncorrectOwner\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InsufficientApproval\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidApprover\",\"inputs\":[{\"name\":\"approver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidOperator\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidReceiver\",\"inputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidSender\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721NonexistentToken\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"EnforcedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ExpectedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidPrize\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotAllowedToMint\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"TokenTransferNotAllowed\",\"inputs\":[]}]",
+	ID:  "ScratchCardTokenV1",
+}
+
+// ScratchCardTokenV1 is an auto generated Go binding around an Ethereum contract.
+type ScratchCardTokenV1 struct {
+	abi abi.ABI
+}
+
+// NewScratchCardTokenV1 creates a new instance of ScratchCardTokenV1.
+func NewScratchCardTokenV1() *ScratchCardTokenV1 {
+	parsed, err := ScratchCardTokenV1MetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &ScratchCardTokenV1{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *ScratchCardTokenV1) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackApprove is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x095ea7b3.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackApprove(to common.Address, tokenId *big.Int) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("approve", to, tokenId)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackApprove is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x095ea7b3.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackApprove(to common.Address, tokenId *big.Int) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("approve", to, tokenId)
+}
+
+// PackBalanceOf is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x70a08231.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackBalanceOf(owner common.Address) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("balanceOf", owner)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackBalanceOf is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x70a08231.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackBalanceOf(owner common.Address) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("balanceOf", owner)
+}
+
+// UnpackBalanceOf is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackBalanceOf(data []byte) (*big.Int, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("balanceOf", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackGetApproved is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x081812fc.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackGetApproved(tokenId *big.Int) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("getApproved", tokenId)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetApproved is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x081812fc.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackGetApproved(tokenId *big.Int) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("getApproved", tokenId)
+}
+
+// UnpackGetApproved is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackGetApproved(data []byte) (common.Address, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("getApproved", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackIsApprovedForAll is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe985e9c5.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackIsApprovedForAll(owner common.Address, operator common.Address) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("isApprovedForAll", owner, operator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackIsApprovedForAll is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe985e9c5.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackIsApprovedForAll(owner common.Address, operator common.Address) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("isApprovedForAll", owner, operator)
+}
+
+// UnpackIsApprovedForAll is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackIsApprovedForAll(data []byte) (bool, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("isApprovedForAll", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, nil
+}
+
+// PackName is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x06fdde03.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function name() view returns(string)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackName() []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("name")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackName is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x06fdde03.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function name() view returns(string)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackName() ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("name")
+}
+
+// UnpackName is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackName(data []byte) (string, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("name", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// PackOwner is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8da5cb5b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function owner() view returns(address)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackOwner() []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("owner")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackOwner is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8da5cb5b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function owner() view returns(address)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackOwner() ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("owner")
+}
+
+// UnpackOwner is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackOwner(data []byte) (common.Address, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("owner", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackOwnerOf is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x6352211e.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackOwnerOf(tokenId *big.Int) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("ownerOf", tokenId)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackOwnerOf is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x6352211e.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackOwnerOf(tokenId *big.Int) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("ownerOf", tokenId)
+}
+
+// UnpackOwnerOf is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackOwnerOf(data []byte) (common.Address, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("ownerOf", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackPause is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8456cb59.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function pause() returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackPause() []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("pause")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackPause is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8456cb59.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function pause() returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackPause() ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("pause")
+}
+
+// PackPaused is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x5c975abb.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function paused() view returns(bool)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackPaused() []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("paused")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackPaused is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x5c975abb.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function paused() view returns(bool)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackPaused() ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("paused")
+}
+
+// UnpackPaused is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackPaused(data []byte) (bool, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("paused", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, nil
+}
+
+// PackPrizeTokenUris is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8e216408.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function prizeTokenUris(uint8 prize) view returns(string tokenUri)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackPrizeTokenUris(prize uint8) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("prizeTokenUris", prize)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackPrizeTokenUris is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8e216408.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function prizeTokenUris(uint8 prize) view returns(string tokenUri)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackPrizeTokenUris(prize uint8) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("prizeTokenUris", prize)
+}
+
+// UnpackPrizeTokenUris is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x8e216408.
+//
+// Solidity: function prizeTokenUris(uint8 prize) view returns(string tokenUri)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackPrizeTokenUris(data []byte) (string, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("prizeTokenUris", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// PackRenounceOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x715018a6.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function renounceOwnership() returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackRenounceOwnership() []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("renounceOwnership")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRenounceOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x715018a6.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function renounceOwnership() returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackRenounceOwnership() ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("renounceOwnership")
+}
+
+// PackSafeMint is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x761c4c40.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function safeMint(address to, uint8 prize) returns(uint256)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackSafeMint(to common.Address, prize uint8) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("safeMint", to, prize)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSafeMint is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x761c4c40.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function safeMint(address to, uint8 prize) returns(uint256)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackSafeMint(to common.Address, prize uint8) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("safeMint", to, prize)
+}
+
+// UnpackSafeMint is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x761c4c40.
+//
+// Solidity: function safeMint(address to, uint8 prize) returns(uint256)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackSafeMint(data []byte) (*big.Int, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("safeMint", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackSafeTransferFrom is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x42842e0e.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackSafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("safeTransferFrom", from, to, tokenId)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSafeTransferFrom is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x42842e0e.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackSafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("safeTransferFrom", from, to, tokenId)
+}
+
+// PackSafeTransferFrom0 is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb88d4fde.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackSafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("safeTransferFrom0", from, to, tokenId, data)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSafeTransferFrom0 is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb88d4fde.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackSafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("safeTransferFrom0", from, to, tokenId, data)
+}
+
+// PackSetAllowedMinter is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd01cc618.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setAllowedMinter(address minter) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackSetAllowedMinter(minter common.Address) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("setAllowedMinter", minter)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetAllowedMinter is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd01cc618.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setAllowedMinter(address minter) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackSetAllowedMinter(minter common.Address) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("setAllowedMinter", minter)
+}
+
+// PackSetApprovalForAll is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa22cb465.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackSetApprovalForAll(operator common.Address, approved bool) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("setApprovalForAll", operator, approved)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetApprovalForAll is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa22cb465.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackSetApprovalForAll(operator common.Address, approved bool) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("setApprovalForAll", operator, approved)
+}
+
+// PackSupportsInterface is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x01ffc9a7.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackSupportsInterface(interfaceId [4]byte) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("supportsInterface", interfaceId)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSupportsInterface is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x01ffc9a7.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackSupportsInterface(interfaceId [4]byte) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("supportsInterface", interfaceId)
+}
+
+// UnpackSupportsInterface is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackSupportsInterface(data []byte) (bool, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("supportsInterface", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, nil
+}
+
+// PackSymbol is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x95d89b41.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function symbol() view returns(string)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackSymbol() []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("symbol")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSymbol is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x95d89b41.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function symbol() view returns(string)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackSymbol() ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("symbol")
+}
+
+// UnpackSymbol is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackSymbol(data []byte) (string, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("symbol", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// PackTokenURI is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc87b56dd.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackTokenURI(tokenId *big.Int) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("tokenURI", tokenId)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTokenURI is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc87b56dd.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackTokenURI(tokenId *big.Int) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("tokenURI", tokenId)
+}
+
+// UnpackTokenURI is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackTokenURI(data []byte) (string, error) {
+	out, err := scratchCardTokenV1.abi.Unpack("tokenURI", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// PackTransferFrom is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x23b872dd.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackTransferFrom(from common.Address, to common.Address, tokenId *big.Int) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("transferFrom", from, to, tokenId)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTransferFrom is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x23b872dd.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackTransferFrom(from common.Address, to common.Address, tokenId *big.Int) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("transferFrom", from, to, tokenId)
+}
+
+// PackTransferOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf2fde38b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackTransferOwnership(newOwner common.Address) []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("transferOwnership", newOwner)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTransferOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf2fde38b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackTransferOwnership(newOwner common.Address) ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("transferOwnership", newOwner)
+}
+
+// PackUnpause is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3f4ba83a.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function unpause() returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) PackUnpause() []byte {
+	enc, err := scratchCardTokenV1.abi.Pack("unpause")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackUnpause is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3f4ba83a.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function unpause() returns()
+func (scratchCardTokenV1 *ScratchCardTokenV1) TryPackUnpause() ([]byte, error) {
+	return scratchCardTokenV1.abi.Pack("unpause")
+}
+
+// ScratchCardTokenV1Approval represents a Approval event raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1Approval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardTokenV1ApprovalEventName = "Approval"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardTokenV1Approval) ContractEventName() string {
+	return ScratchCardTokenV1ApprovalEventName
+}
+
+// UnpackApprovalEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackApprovalEvent(log *types.Log) (*ScratchCardTokenV1Approval, error) {
+	event := "Approval"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardTokenV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardTokenV1Approval)
+	if len(log.Data) > 0 {
+		if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardTokenV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardTokenV1ApprovalForAll represents a ApprovalForAll event raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1ApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardTokenV1ApprovalForAllEventName = "ApprovalForAll"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardTokenV1ApprovalForAll) ContractEventName() string {
+	return ScratchCardTokenV1ApprovalForAllEventName
+}
+
+// UnpackApprovalForAllEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackApprovalForAllEvent(log *types.Log) (*ScratchCardTokenV1ApprovalForAll, error) {
+	event := "ApprovalForAll"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardTokenV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardTokenV1ApprovalForAll)
+	if len(log.Data) > 0 {
+		if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardTokenV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardTokenV1BatchMetadataUpdate represents a BatchMetadataUpdate event raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1BatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardTokenV1BatchMetadataUpdateEventName = "BatchMetadataUpdate"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardTokenV1BatchMetadataUpdate) ContractEventName() string {
+	return ScratchCardTokenV1BatchMetadataUpdateEventName
+}
+
+// UnpackBatchMetadataUpdateEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackBatchMetadataUpdateEvent(log *types.Log) (*ScratchCardTokenV1BatchMetadataUpdate, error) {
+	event := "BatchMetadataUpdate"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardTokenV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardTokenV1BatchMetadataUpdate)
+	if len(log.Data) > 0 {
+		if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardTokenV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardTokenV1MetadataUpdate represents a MetadataUpdate event raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1MetadataUpdate struct {
+	TokenId *big.Int
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardTokenV1MetadataUpdateEventName = "MetadataUpdate"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardTokenV1MetadataUpdate) ContractEventName() string {
+	return ScratchCardTokenV1MetadataUpdateEventName
+}
+
+// UnpackMetadataUpdateEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackMetadataUpdateEvent(log *types.Log) (*ScratchCardTokenV1MetadataUpdate, error) {
+	event := "MetadataUpdate"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardTokenV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardTokenV1MetadataUpdate)
+	if len(log.Data) > 0 {
+		if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardTokenV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardTokenV1OwnershipTransferred represents a OwnershipTransferred event raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1OwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardTokenV1OwnershipTransferredEventName = "OwnershipTransferred"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardTokenV1OwnershipTransferred) ContractEventName() string {
+	return ScratchCardTokenV1OwnershipTransferredEventName
+}
+
+// UnpackOwnershipTransferredEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackOwnershipTransferredEvent(log *types.Log) (*ScratchCardTokenV1OwnershipTransferred, error) {
+	event := "OwnershipTransferred"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardTokenV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardTokenV1OwnershipTransferred)
+	if len(log.Data) > 0 {
+		if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardTokenV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardTokenV1Paused represents a Paused event raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1Paused struct {
+	Account common.Address
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardTokenV1PausedEventName = "Paused"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardTokenV1Paused) ContractEventName() string {
+	return ScratchCardTokenV1PausedEventName
+}
+
+// UnpackPausedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Paused(address account)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackPausedEvent(log *types.Log) (*ScratchCardTokenV1Paused, error) {
+	event := "Paused"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardTokenV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardTokenV1Paused)
+	if len(log.Data) > 0 {
+		if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardTokenV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardTokenV1Transfer represents a Transfer event raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1Transfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardTokenV1TransferEventName = "Transfer"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardTokenV1Transfer) ContractEventName() string {
+	return ScratchCardTokenV1TransferEventName
+}
+
+// UnpackTransferEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackTransferEvent(log *types.Log) (*ScratchCardTokenV1Transfer, error) {
+	event := "Transfer"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardTokenV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardTokenV1Transfer)
+	if len(log.Data) > 0 {
+		if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardTokenV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardTokenV1Unpaused represents a Unpaused event raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1Unpaused struct {
+	Account common.Address
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardTokenV1UnpausedEventName = "Unpaused"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardTokenV1Unpaused) ContractEventName() string {
+	return ScratchCardTokenV1UnpausedEventName
+}
+
+// UnpackUnpausedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Unpaused(address account)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackUnpausedEvent(log *types.Log) (*ScratchCardTokenV1Unpaused, error) {
+	event := "Unpaused"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardTokenV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardTokenV1Unpaused)
+	if len(log.Data) > 0 {
+		if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardTokenV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// UnpackError attempts to decode the provided error data using user-defined
+// error definitions.
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackError(raw []byte) (any, error) {
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["ERC721IncorrectOwner"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackERC721IncorrectOwnerError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["ERC721InsufficientApproval"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackERC721InsufficientApprovalError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["ERC721InvalidApprover"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackERC721InvalidApproverError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["ERC721InvalidOperator"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackERC721InvalidOperatorError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["ERC721InvalidOwner"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackERC721InvalidOwnerError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["ERC721InvalidReceiver"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackERC721InvalidReceiverError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["ERC721InvalidSender"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackERC721InvalidSenderError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["ERC721NonexistentToken"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackERC721NonexistentTokenError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["EnforcedPause"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackEnforcedPauseError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["ExpectedPause"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackExpectedPauseError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["InvalidPrize"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackInvalidPrizeError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["NotAllowedToMint"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackNotAllowedToMintError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["OwnableInvalidOwner"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackOwnableInvalidOwnerError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["OwnableUnauthorizedAccount"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackOwnableUnauthorizedAccountError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardTokenV1.abi.Errors["TokenTransferNotAllowed"].ID.Bytes()[:4]) {
+		return scratchCardTokenV1.UnpackTokenTransferNotAllowedError(raw[4:])
+	}
+	return nil, errors.New("Unknown error")
+}
+
+// ScratchCardTokenV1ERC721IncorrectOwner represents a ERC721IncorrectOwner error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1ERC721IncorrectOwner struct {
+	Sender  common.Address
+	TokenId *big.Int
+	Owner   common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC721IncorrectOwner(address sender, uint256 tokenId, address owner)
+func ScratchCardTokenV1ERC721IncorrectOwnerErrorID() common.Hash {
+	return common.HexToHash("0x64283d7b313c8117c125f736876fa2b4e90ea3831a4716dfdb87d2f540e26289")
+}
+
+// UnpackERC721IncorrectOwnerError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC721IncorrectOwner(address sender, uint256 tokenId, address owner)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackERC721IncorrectOwnerError(raw []byte) (*ScratchCardTokenV1ERC721IncorrectOwner, error) {
+	out := new(ScratchCardTokenV1ERC721IncorrectOwner)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "ERC721IncorrectOwner", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1ERC721InsufficientApproval represents a ERC721InsufficientApproval error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1ERC721InsufficientApproval struct {
+	Operator common.Address
+	TokenId  *big.Int
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC721InsufficientApproval(address operator, uint256 tokenId)
+func ScratchCardTokenV1ERC721InsufficientApprovalErrorID() common.Hash {
+	return common.HexToHash("0x177e802f6f313bc89797ecace66d6d29ab4719cbaaacbb87367264048b1eb861")
+}
+
+// UnpackERC721InsufficientApprovalError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC721InsufficientApproval(address operator, uint256 tokenId)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackERC721InsufficientApprovalError(raw []byte) (*ScratchCardTokenV1ERC721InsufficientApproval, error) {
+	out := new(ScratchCardTokenV1ERC721InsufficientApproval)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "ERC721InsufficientApproval", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1ERC721InvalidApprover represents a ERC721InvalidApprover error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1ERC721InvalidApprover struct {
+	Approver common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC721InvalidApprover(address approver)
+func ScratchCardTokenV1ERC721InvalidApproverErrorID() common.Hash {
+	return common.HexToHash("0xa9fbf51f86b8e03595d59dc726bb10c329bb24f62589be276d8dd193ca0b69ea")
+}
+
+// UnpackERC721InvalidApproverError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC721InvalidApprover(address approver)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackERC721InvalidApproverError(raw []byte) (*ScratchCardTokenV1ERC721InvalidApprover, error) {
+	out := new(ScratchCardTokenV1ERC721InvalidApprover)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "ERC721InvalidApprover", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1ERC721InvalidOperator represents a ERC721InvalidOperator error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1ERC721InvalidOperator struct {
+	Operator common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC721InvalidOperator(address operator)
+func ScratchCardTokenV1ERC721InvalidOperatorErrorID() common.Hash {
+	return common.HexToHash("0x5b08ba185e8f577075361f3a3555a6580a227ce22734dcc979c1aeadf894658b")
+}
+
+// UnpackERC721InvalidOperatorError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC721InvalidOperator(address operator)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackERC721InvalidOperatorError(raw []byte) (*ScratchCardTokenV1ERC721InvalidOperator, error) {
+	out := new(ScratchCardTokenV1ERC721InvalidOperator)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "ERC721InvalidOperator", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1ERC721InvalidOwner represents a ERC721InvalidOwner error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1ERC721InvalidOwner struct {
+	Owner common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC721InvalidOwner(address owner)
+func ScratchCardTokenV1ERC721InvalidOwnerErrorID() common.Hash {
+	return common.HexToHash("0x89c62b6479af2e623826dcc39c5133061d35b66d72de92833401dd2fd6567480")
+}
+
+// UnpackERC721InvalidOwnerError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC721InvalidOwner(address owner)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackERC721InvalidOwnerError(raw []byte) (*ScratchCardTokenV1ERC721InvalidOwner, error) {
+	out := new(ScratchCardTokenV1ERC721InvalidOwner)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "ERC721InvalidOwner", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1ERC721InvalidReceiver represents a ERC721InvalidReceiver error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1ERC721InvalidReceiver struct {
+	Receiver common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC721InvalidReceiver(address receiver)
+func ScratchCardTokenV1ERC721InvalidReceiverErrorID() common.Hash {
+	return common.HexToHash("0x64a0ae9278f805eaf991dcd18ca78756d280b7508b764ef1b255c55845c11df9")
+}
+
+// UnpackERC721InvalidReceiverError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC721InvalidReceiver(address receiver)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackERC721InvalidReceiverError(raw []byte) (*ScratchCardTokenV1ERC721InvalidReceiver, error) {
+	out := new(ScratchCardTokenV1ERC721InvalidReceiver)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "ERC721InvalidReceiver", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1ERC721InvalidSender represents a ERC721InvalidSender error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1ERC721InvalidSender struct {
+	Sender common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC721InvalidSender(address sender)
+func ScratchCardTokenV1ERC721InvalidSenderErrorID() common.Hash {
+	return common.HexToHash("0x73c6ac6e10798e95d99e1f130d923eb40193ecb8d094ec3dce93292564eb3b17")
+}
+
+// UnpackERC721InvalidSenderError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC721InvalidSender(address sender)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackERC721InvalidSenderError(raw []byte) (*ScratchCardTokenV1ERC721InvalidSender, error) {
+	out := new(ScratchCardTokenV1ERC721InvalidSender)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "ERC721InvalidSender", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1ERC721NonexistentToken represents a ERC721NonexistentToken error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1ERC721NonexistentToken struct {
+	TokenId *big.Int
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC721NonexistentToken(uint256 tokenId)
+func ScratchCardTokenV1ERC721NonexistentTokenErrorID() common.Hash {
+	return common.HexToHash("0x7e273289a3a9ef6670f06df7dca227856fc925e956db96980692764a8bc734d7")
+}
+
+// UnpackERC721NonexistentTokenError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC721NonexistentToken(uint256 tokenId)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackERC721NonexistentTokenError(raw []byte) (*ScratchCardTokenV1ERC721NonexistentToken, error) {
+	out := new(ScratchCardTokenV1ERC721NonexistentToken)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "ERC721NonexistentToken", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1EnforcedPause represents a EnforcedPause error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1EnforcedPause struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error EnforcedPause()
+func ScratchCardTokenV1EnforcedPauseErrorID() common.Hash {
+	return common.HexToHash("0xd93c0665d6c96d04a8f174024fc4ddd66c250604aff22bbec808de86dd3637e3")
+}
+
+// UnpackEnforcedPauseError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error EnforcedPause()
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackEnforcedPauseError(raw []byte) (*ScratchCardTokenV1EnforcedPause, error) {
+	out := new(ScratchCardTokenV1EnforcedPause)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "EnforcedPause", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1ExpectedPause represents a ExpectedPause error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1ExpectedPause struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ExpectedPause()
+func ScratchCardTokenV1ExpectedPauseErrorID() common.Hash {
+	return common.HexToHash("0x8dfc202bcfe9a735b559bee70674422512bc5c30f687046ae8778315fb81da44")
+}
+
+// UnpackExpectedPauseError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ExpectedPause()
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackExpectedPauseError(raw []byte) (*ScratchCardTokenV1ExpectedPause, error) {
+	out := new(ScratchCardTokenV1ExpectedPause)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "ExpectedPause", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1InvalidPrize represents a InvalidPrize error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1InvalidPrize struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error InvalidPrize()
+func ScratchCardTokenV1InvalidPrizeErrorID() common.Hash {
+	return common.HexToHash("0x5f12e2ee4edfefb35be78ad72ca02ce6303882b88de2e70dfa50295bd18c7e32")
+}
+
+// UnpackInvalidPrizeError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error InvalidPrize()
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackInvalidPrizeError(raw []byte) (*ScratchCardTokenV1InvalidPrize, error) {
+	out := new(ScratchCardTokenV1InvalidPrize)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "InvalidPrize", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1NotAllowedToMint represents a NotAllowedToMint error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1NotAllowedToMint struct {
+	Sender common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error NotAllowedToMint(address sender)
+func ScratchCardTokenV1NotAllowedToMintErrorID() common.Hash {
+	return common.HexToHash("0x82b3806d0cbc335a9c7c7889f2f388487d16851cb7e6ace4382a747cccb9372f")
+}
+
+// UnpackNotAllowedToMintError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error NotAllowedToMint(address sender)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackNotAllowedToMintError(raw []byte) (*ScratchCardTokenV1NotAllowedToMint, error) {
+	out := new(ScratchCardTokenV1NotAllowedToMint)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "NotAllowedToMint", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1OwnableInvalidOwner represents a OwnableInvalidOwner error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1OwnableInvalidOwner struct {
+	Owner common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OwnableInvalidOwner(address owner)
+func ScratchCardTokenV1OwnableInvalidOwnerErrorID() common.Hash {
+	return common.HexToHash("0x1e4fbdf7f3ef8bcaa855599e3abf48b232380f183f08f6f813d9ffa5bd585188")
+}
+
+// UnpackOwnableInvalidOwnerError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OwnableInvalidOwner(address owner)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackOwnableInvalidOwnerError(raw []byte) (*ScratchCardTokenV1OwnableInvalidOwner, error) {
+	out := new(ScratchCardTokenV1OwnableInvalidOwner)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "OwnableInvalidOwner", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1OwnableUnauthorizedAccount represents a OwnableUnauthorizedAccount error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1OwnableUnauthorizedAccount struct {
+	Account common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OwnableUnauthorizedAccount(address account)
+func ScratchCardTokenV1OwnableUnauthorizedAccountErrorID() common.Hash {
+	return common.HexToHash("0x118cdaa7a341953d1887a2245fd6665d741c67c8c50581daa59e1d03373fa188")
+}
+
+// UnpackOwnableUnauthorizedAccountError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OwnableUnauthorizedAccount(address account)
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackOwnableUnauthorizedAccountError(raw []byte) (*ScratchCardTokenV1OwnableUnauthorizedAccount, error) {
+	out := new(ScratchCardTokenV1OwnableUnauthorizedAccount)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "OwnableUnauthorizedAccount", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardTokenV1TokenTransferNotAllowed represents a TokenTransferNotAllowed error raised by the ScratchCardTokenV1 contract.
+type ScratchCardTokenV1TokenTransferNotAllowed struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error TokenTransferNotAllowed()
+func ScratchCardTokenV1TokenTransferNotAllowedErrorID() common.Hash {
+	return common.HexToHash("0xca7cbc7735271a1c8064554ba57d59741f0848b2f93351b3c15493e1fca24c9a")
+}
+
+// UnpackTokenTransferNotAllowedError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error TokenTransferNotAllowed()
+func (scratchCardTokenV1 *ScratchCardTokenV1) UnpackTokenTransferNotAllowedError(raw []byte) (*ScratchCardTokenV1TokenTransferNotAllowed, error) {
+	out := new(ScratchCardTokenV1TokenTransferNotAllowed)
+	if err := scratchCardTokenV1.abi.UnpackIntoInterface(out, "TokenTransferNotAllowed", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
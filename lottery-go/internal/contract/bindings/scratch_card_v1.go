@@ -0,0 +1,1252 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// ScratchCardV1MetaData contains all meta data concerning the ScratchCardV1 contract.
+var ScratchCardV1MetaData = bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"UPGRADE_INTERFACE_VERSION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"callbackFromRand\",\"inputs\":[{\"name\":\"_user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_randomNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"configContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIScratchCardConfig\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"fund\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"grandWinners\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"prize\",\"type\":\"uint8\",\"internalType\":\"enumScratchCardPrize\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_resultAddr\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_tokenAddr\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_randProviderAddr\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_configAddr\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"luckyWinners\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"prize\",\"type\":\"uint8\",\"internalType\":\"enumScratchCardPrize\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"proxiableUUID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"randProviderContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIScratchCardRandProvider\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resultContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIScratchCardResult\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"scratchCard\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"setConfigAddress\",\"inputs\":[{\"name\":\"_address\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setRandProviderAddress\",\"inputs\":[{\"name\":\"_address\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setResultAddress\",\"inputs\":[{\"name\":\"_address\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTokenAddress\",\"inputs\":[{\"name\":\"_address\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"smallWinners\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"prize\",\"type\":\"uint8\",\"internalType\":\"enumScratchCardPrize\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIScratchCardToken\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"upgradeToAndCall\",\"inputs\":[{\"name\":\"newImplementation\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"LotteryResultEvent\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"prize\",\"type\":\"uint8\",\"indexed\":true,\"internalType\":\"enumScratchCardPrize\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"randomNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ScratchCardEvent\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Upgraded\",\"inputs\":[{\"name\":\"implementation\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AddressEmptyCode\",\"inputs\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC1967InvalidImplementation\",\"inputs\":[{\"name\":\"implementation\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC1967NonPayable\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"FailedCall\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OnlyRandProvider\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"TransferFailed\",\"inputs\":[{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"UUPSUnauthorizedCallContext\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UUPSUnsupportedProxiableUUID\",\"inputs\":[{\"name\":\"slot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"WrongPrice\",\"inputs\":[{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}]",
+	ID:  "ScratchCardV1",
+}
+
+// ScratchCardV1 is an auto generated Go binding around an Ethereum contract.
+type ScratchCardV1 struct {
+	abi abi.ABI
+}
+
+// NewScratchCardV1 creates a new instance of ScratchCardV1.
+func NewScratchCardV1() *ScratchCardV1 {
+	parsed, err := ScratchCardV1MetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &ScratchCardV1{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *ScratchCardV1) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackUPGRADEINTERFACEVERSION is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xad3cb1cc.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (scratchCardV1 *ScratchCardV1) PackUPGRADEINTERFACEVERSION() []byte {
+	enc, err := scratchCardV1.abi.Pack("UPGRADE_INTERFACE_VERSION")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackUPGRADEINTERFACEVERSION is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xad3cb1cc.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (scratchCardV1 *ScratchCardV1) TryPackUPGRADEINTERFACEVERSION() ([]byte, error) {
+	return scratchCardV1.abi.Pack("UPGRADE_INTERFACE_VERSION")
+}
+
+// UnpackUPGRADEINTERFACEVERSION is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (scratchCardV1 *ScratchCardV1) UnpackUPGRADEINTERFACEVERSION(data []byte) (string, error) {
+	out, err := scratchCardV1.abi.Unpack("UPGRADE_INTERFACE_VERSION", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, nil
+}
+
+// PackCallbackFromRand is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe165f2a1.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function callbackFromRand(address _user, uint256 _randomNumber) returns()
+func (scratchCardV1 *ScratchCardV1) PackCallbackFromRand(user common.Address, randomNumber *big.Int) []byte {
+	enc, err := scratchCardV1.abi.Pack("callbackFromRand", user, randomNumber)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackCallbackFromRand is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe165f2a1.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function callbackFromRand(address _user, uint256 _randomNumber) returns()
+func (scratchCardV1 *ScratchCardV1) TryPackCallbackFromRand(user common.Address, randomNumber *big.Int) ([]byte, error) {
+	return scratchCardV1.abi.Pack("callbackFromRand", user, randomNumber)
+}
+
+// PackConfigContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xbf66a182.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function configContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) PackConfigContract() []byte {
+	enc, err := scratchCardV1.abi.Pack("configContract")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackConfigContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xbf66a182.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function configContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) TryPackConfigContract() ([]byte, error) {
+	return scratchCardV1.abi.Pack("configContract")
+}
+
+// UnpackConfigContract is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xbf66a182.
+//
+// Solidity: function configContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) UnpackConfigContract(data []byte) (common.Address, error) {
+	out, err := scratchCardV1.abi.Unpack("configContract", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackFund is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb60d4288.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function fund() payable returns()
+func (scratchCardV1 *ScratchCardV1) PackFund() []byte {
+	enc, err := scratchCardV1.abi.Pack("fund")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackFund is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb60d4288.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function fund() payable returns()
+func (scratchCardV1 *ScratchCardV1) TryPackFund() ([]byte, error) {
+	return scratchCardV1.abi.Pack("fund")
+}
+
+// PackGrandWinners is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa644200e.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function grandWinners(uint256 ) view returns(address user, uint8 prize, uint256 tokenId, uint256 amount, uint256 timestamp)
+func (scratchCardV1 *ScratchCardV1) PackGrandWinners(arg0 *big.Int) []byte {
+	enc, err := scratchCardV1.abi.Pack("grandWinners", arg0)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGrandWinners is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa644200e.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function grandWinners(uint256 ) view returns(address user, uint8 prize, uint256 tokenId, uint256 amount, uint256 timestamp)
+func (scratchCardV1 *ScratchCardV1) TryPackGrandWinners(arg0 *big.Int) ([]byte, error) {
+	return scratchCardV1.abi.Pack("grandWinners", arg0)
+}
+
+// GrandWinnersOutput serves as a container for the return parameters of contract
+// method GrandWinners.
+type GrandWinnersOutput struct {
+	User      common.Address
+	Prize     uint8
+	TokenId   *big.Int
+	Amount    *big.Int
+	Timestamp *big.Int
+}
+
+// UnpackGrandWinners is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xa644200e.
+//
+// Solidity: function grandWinners(uint256 ) view returns(address user, uint8 prize, uint256 tokenId, uint256 amount, uint256 timestamp)
+func (scratchCardV1 *ScratchCardV1) UnpackGrandWinners(data []byte) (GrandWinnersOutput, error) {
+	out, err := scratchCardV1.abi.Unpack("grandWinners", data)
+	outstruct := new(GrandWinnersOutput)
+	if err != nil {
+		return *outstruct, err
+	}
+	outstruct.User = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Prize = *abi.ConvertType(out[1], new(uint8)).(*uint8)
+	outstruct.TokenId = abi.ConvertType(out[2], new(big.Int)).(*big.Int)
+	outstruct.Amount = abi.ConvertType(out[3], new(big.Int)).(*big.Int)
+	outstruct.Timestamp = abi.ConvertType(out[4], new(big.Int)).(*big.Int)
+	return *outstruct, nil
+}
+
+// PackInitialize is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf8c8765e.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function initialize(address _resultAddr, address _tokenAddr, address _randProviderAddr, address _configAddr) returns()
+func (scratchCardV1 *ScratchCardV1) PackInitialize(resultAddr common.Address, tokenAddr common.Address, randProviderAddr common.Address, configAddr common.Address) []byte {
+	enc, err := scratchCardV1.abi.Pack("initialize", resultAddr, tokenAddr, randProviderAddr, configAddr)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackInitialize is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf8c8765e.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function initialize(address _resultAddr, address _tokenAddr, address _randProviderAddr, address _configAddr) returns()
+func (scratchCardV1 *ScratchCardV1) TryPackInitialize(resultAddr common.Address, tokenAddr common.Address, randProviderAddr common.Address, configAddr common.Address) ([]byte, error) {
+	return scratchCardV1.abi.Pack("initialize", resultAddr, tokenAddr, randProviderAddr, configAddr)
+}
+
+// PackLuckyWinners is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc2522598.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function luckyWinners(uint256 ) view returns(address user, uint8 prize, uint256 tokenId, uint256 amount, uint256 timestamp)
+func (scratchCardV1 *ScratchCardV1) PackLuckyWinners(arg0 *big.Int) []byte {
+	enc, err := scratchCardV1.abi.Pack("luckyWinners", arg0)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackLuckyWinners is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc2522598.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function luckyWinners(uint256 ) view returns(address user, uint8 prize, uint256 tokenId, uint256 amount, uint256 timestamp)
+func (scratchCardV1 *ScratchCardV1) TryPackLuckyWinners(arg0 *big.Int) ([]byte, error) {
+	return scratchCardV1.abi.Pack("luckyWinners", arg0)
+}
+
+// LuckyWinnersOutput serves as a container for the return parameters of contract
+// method LuckyWinners.
+type LuckyWinnersOutput struct {
+	User      common.Address
+	Prize     uint8
+	TokenId   *big.Int
+	Amount    *big.Int
+	Timestamp *big.Int
+}
+
+// UnpackLuckyWinners is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xc2522598.
+//
+// Solidity: function luckyWinners(uint256 ) view returns(address user, uint8 prize, uint256 tokenId, uint256 amount, uint256 timestamp)
+func (scratchCardV1 *ScratchCardV1) UnpackLuckyWinners(data []byte) (LuckyWinnersOutput, error) {
+	out, err := scratchCardV1.abi.Unpack("luckyWinners", data)
+	outstruct := new(LuckyWinnersOutput)
+	if err != nil {
+		return *outstruct, err
+	}
+	outstruct.User = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Prize = *abi.ConvertType(out[1], new(uint8)).(*uint8)
+	outstruct.TokenId = abi.ConvertType(out[2], new(big.Int)).(*big.Int)
+	outstruct.Amount = abi.ConvertType(out[3], new(big.Int)).(*big.Int)
+	outstruct.Timestamp = abi.ConvertType(out[4], new(big.Int)).(*big.Int)
+	return *outstruct, nil
+}
+
+// PackOwner is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8da5cb5b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function owner() view returns(address)
+func (scratchCardV1 *ScratchCardV1) PackOwner() []byte {
+	enc, err := scratchCardV1.abi.Pack("owner")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackOwner is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8da5cb5b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function owner() view returns(address)
+func (scratchCardV1 *ScratchCardV1) TryPackOwner() ([]byte, error) {
+	return scratchCardV1.abi.Pack("owner")
+}
+
+// UnpackOwner is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (scratchCardV1 *ScratchCardV1) UnpackOwner(data []byte) (common.Address, error) {
+	out, err := scratchCardV1.abi.Unpack("owner", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackProxiableUUID is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x52d1902d.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (scratchCardV1 *ScratchCardV1) PackProxiableUUID() []byte {
+	enc, err := scratchCardV1.abi.Pack("proxiableUUID")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackProxiableUUID is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x52d1902d.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (scratchCardV1 *ScratchCardV1) TryPackProxiableUUID() ([]byte, error) {
+	return scratchCardV1.abi.Pack("proxiableUUID")
+}
+
+// UnpackProxiableUUID is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (scratchCardV1 *ScratchCardV1) UnpackProxiableUUID(data []byte) ([32]byte, error) {
+	out, err := scratchCardV1.abi.Unpack("proxiableUUID", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, nil
+}
+
+// PackRandProviderContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd1f5a562.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function randProviderContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) PackRandProviderContract() []byte {
+	enc, err := scratchCardV1.abi.Pack("randProviderContract")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRandProviderContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd1f5a562.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function randProviderContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) TryPackRandProviderContract() ([]byte, error) {
+	return scratchCardV1.abi.Pack("randProviderContract")
+}
+
+// UnpackRandProviderContract is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xd1f5a562.
+//
+// Solidity: function randProviderContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) UnpackRandProviderContract(data []byte) (common.Address, error) {
+	out, err := scratchCardV1.abi.Unpack("randProviderContract", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackRenounceOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x715018a6.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function renounceOwnership() returns()
+func (scratchCardV1 *ScratchCardV1) PackRenounceOwnership() []byte {
+	enc, err := scratchCardV1.abi.Pack("renounceOwnership")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRenounceOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x715018a6.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function renounceOwnership() returns()
+func (scratchCardV1 *ScratchCardV1) TryPackRenounceOwnership() ([]byte, error) {
+	return scratchCardV1.abi.Pack("renounceOwnership")
+}
+
+// PackResultContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x58816f9f.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function resultContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) PackResultContract() []byte {
+	enc, err := scratchCardV1.abi.Pack("resultContract")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackResultContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x58816f9f.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function resultContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) TryPackResultContract() ([]byte, error) {
+	return scratchCardV1.abi.Pack("resultContract")
+}
+
+// UnpackResultContract is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x58816f9f.
+//
+// Solidity: function resultContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) UnpackResultContract(data []byte) (common.Address, error) {
+	out, err := scratchCardV1.abi.Unpack("resultContract", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackScratchCard is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x991a409b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function scratchCard() payable returns()
+func (scratchCardV1 *ScratchCardV1) PackScratchCard() []byte {
+	enc, err := scratchCardV1.abi.Pack("scratchCard")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackScratchCard is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x991a409b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function scratchCard() payable returns()
+func (scratchCardV1 *ScratchCardV1) TryPackScratchCard() ([]byte, error) {
+	return scratchCardV1.abi.Pack("scratchCard")
+}
+
+// PackSetConfigAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x83a12de9.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setConfigAddress(address _address) returns()
+func (scratchCardV1 *ScratchCardV1) PackSetConfigAddress(address common.Address) []byte {
+	enc, err := scratchCardV1.abi.Pack("setConfigAddress", address)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetConfigAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x83a12de9.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setConfigAddress(address _address) returns()
+func (scratchCardV1 *ScratchCardV1) TryPackSetConfigAddress(address common.Address) ([]byte, error) {
+	return scratchCardV1.abi.Pack("setConfigAddress", address)
+}
+
+// PackSetRandProviderAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3e5218fc.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setRandProviderAddress(address _address) returns()
+func (scratchCardV1 *ScratchCardV1) PackSetRandProviderAddress(address common.Address) []byte {
+	enc, err := scratchCardV1.abi.Pack("setRandProviderAddress", address)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetRandProviderAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3e5218fc.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setRandProviderAddress(address _address) returns()
+func (scratchCardV1 *ScratchCardV1) TryPackSetRandProviderAddress(address common.Address) ([]byte, error) {
+	return scratchCardV1.abi.Pack("setRandProviderAddress", address)
+}
+
+// PackSetResultAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x21dfda49.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setResultAddress(address _address) returns()
+func (scratchCardV1 *ScratchCardV1) PackSetResultAddress(address common.Address) []byte {
+	enc, err := scratchCardV1.abi.Pack("setResultAddress", address)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetResultAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x21dfda49.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setResultAddress(address _address) returns()
+func (scratchCardV1 *ScratchCardV1) TryPackSetResultAddress(address common.Address) ([]byte, error) {
+	return scratchCardV1.abi.Pack("setResultAddress", address)
+}
+
+// PackSetTokenAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x26a4e8d2.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setTokenAddress(address _address) returns()
+func (scratchCardV1 *ScratchCardV1) PackSetTokenAddress(address common.Address) []byte {
+	enc, err := scratchCardV1.abi.Pack("setTokenAddress", address)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetTokenAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x26a4e8d2.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setTokenAddress(address _address) returns()
+func (scratchCardV1 *ScratchCardV1) TryPackSetTokenAddress(address common.Address) ([]byte, error) {
+	return scratchCardV1.abi.Pack("setTokenAddress", address)
+}
+
+// PackSmallWinners is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8cb1ce33.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function smallWinners(uint256 ) view returns(address user, uint8 prize, uint256 tokenId, uint256 amount, uint256 timestamp)
+func (scratchCardV1 *ScratchCardV1) PackSmallWinners(arg0 *big.Int) []byte {
+	enc, err := scratchCardV1.abi.Pack("smallWinners", arg0)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSmallWinners is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8cb1ce33.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function smallWinners(uint256 ) view returns(address user, uint8 prize, uint256 tokenId, uint256 amount, uint256 timestamp)
+func (scratchCardV1 *ScratchCardV1) TryPackSmallWinners(arg0 *big.Int) ([]byte, error) {
+	return scratchCardV1.abi.Pack("smallWinners", arg0)
+}
+
+// SmallWinnersOutput serves as a container for the return parameters of contract
+// method SmallWinners.
+type SmallWinnersOutput struct {
+	User      common.Address
+	Prize     uint8
+	TokenId   *big.Int
+	Amount    *big.Int
+	Timestamp *big.Int
+}
+
+// UnpackSmallWinners is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x8cb1ce33.
+//
+// Solidity: function smallWinners(uint256 ) view returns(address user, uint8 prize, uint256 tokenId, uint256 amount, uint256 timestamp)
+func (scratchCardV1 *ScratchCardV1) UnpackSmallWinners(data []byte) (SmallWinnersOutput, error) {
+	out, err := scratchCardV1.abi.Unpack("smallWinners", data)
+	outstruct := new(SmallWinnersOutput)
+	if err != nil {
+		return *outstruct, err
+	}
+	outstruct.User = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Prize = *abi.ConvertType(out[1], new(uint8)).(*uint8)
+	outstruct.TokenId = abi.ConvertType(out[2], new(big.Int)).(*big.Int)
+	outstruct.Amount = abi.ConvertType(out[3], new(big.Int)).(*big.Int)
+	outstruct.Timestamp = abi.ConvertType(out[4], new(big.Int)).(*big.Int)
+	return *outstruct, nil
+}
+
+// PackTokenContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x55a373d6.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function tokenContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) PackTokenContract() []byte {
+	enc, err := scratchCardV1.abi.Pack("tokenContract")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTokenContract is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x55a373d6.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function tokenContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) TryPackTokenContract() ([]byte, error) {
+	return scratchCardV1.abi.Pack("tokenContract")
+}
+
+// UnpackTokenContract is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x55a373d6.
+//
+// Solidity: function tokenContract() view returns(address)
+func (scratchCardV1 *ScratchCardV1) UnpackTokenContract(data []byte) (common.Address, error) {
+	out, err := scratchCardV1.abi.Unpack("tokenContract", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackTransferOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf2fde38b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (scratchCardV1 *ScratchCardV1) PackTransferOwnership(newOwner common.Address) []byte {
+	enc, err := scratchCardV1.abi.Pack("transferOwnership", newOwner)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTransferOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf2fde38b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (scratchCardV1 *ScratchCardV1) TryPackTransferOwnership(newOwner common.Address) ([]byte, error) {
+	return scratchCardV1.abi.Pack("transferOwnership", newOwner)
+}
+
+// PackUpgradeToAndCall is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x4f1ef286.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (scratchCardV1 *ScratchCardV1) PackUpgradeToAndCall(newImplementation common.Address, data []byte) []byte {
+	enc, err := scratchCardV1.abi.Pack("upgradeToAndCall", newImplementation, data)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackUpgradeToAndCall is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x4f1ef286.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (scratchCardV1 *ScratchCardV1) TryPackUpgradeToAndCall(newImplementation common.Address, data []byte) ([]byte, error) {
+	return scratchCardV1.abi.Pack("upgradeToAndCall", newImplementation, data)
+}
+
+// ScratchCardV1Initialized represents a Initialized event raised by the ScratchCardV1 contract.
+type ScratchCardV1Initialized struct {
+	Version uint64
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardV1InitializedEventName = "Initialized"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardV1Initialized) ContractEventName() string {
+	return ScratchCardV1InitializedEventName
+}
+
+// UnpackInitializedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Initialized(uint64 version)
+func (scratchCardV1 *ScratchCardV1) UnpackInitializedEvent(log *types.Log) (*ScratchCardV1Initialized, error) {
+	event := "Initialized"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardV1Initialized)
+	if len(log.Data) > 0 {
+		if err := scratchCardV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardV1LotteryResultEvent represents a LotteryResultEvent event raised by the ScratchCardV1 contract.
+type ScratchCardV1LotteryResultEvent struct {
+	User         common.Address
+	Timestamp    *big.Int
+	Prize        uint8
+	Amount       *big.Int
+	RandomNumber *big.Int
+	Raw          *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardV1LotteryResultEventEventName = "LotteryResultEvent"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardV1LotteryResultEvent) ContractEventName() string {
+	return ScratchCardV1LotteryResultEventEventName
+}
+
+// UnpackLotteryResultEventEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event LotteryResultEvent(address indexed user, uint256 indexed timestamp, uint8 indexed prize, uint256 amount, uint256 randomNumber)
+func (scratchCardV1 *ScratchCardV1) UnpackLotteryResultEventEvent(log *types.Log) (*ScratchCardV1LotteryResultEvent, error) {
+	event := "LotteryResultEvent"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardV1LotteryResultEvent)
+	if len(log.Data) > 0 {
+		if err := scratchCardV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardV1OwnershipTransferred represents a OwnershipTransferred event raised by the ScratchCardV1 contract.
+type ScratchCardV1OwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardV1OwnershipTransferredEventName = "OwnershipTransferred"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardV1OwnershipTransferred) ContractEventName() string {
+	return ScratchCardV1OwnershipTransferredEventName
+}
+
+// UnpackOwnershipTransferredEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (scratchCardV1 *ScratchCardV1) UnpackOwnershipTransferredEvent(log *types.Log) (*ScratchCardV1OwnershipTransferred, error) {
+	event := "OwnershipTransferred"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardV1OwnershipTransferred)
+	if len(log.Data) > 0 {
+		if err := scratchCardV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardV1ScratchCardEvent represents a ScratchCardEvent event raised by the ScratchCardV1 contract.
+type ScratchCardV1ScratchCardEvent struct {
+	User      common.Address
+	Timestamp *big.Int
+	Value     *big.Int
+	Raw       *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardV1ScratchCardEventEventName = "ScratchCardEvent"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardV1ScratchCardEvent) ContractEventName() string {
+	return ScratchCardV1ScratchCardEventEventName
+}
+
+// UnpackScratchCardEventEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event ScratchCardEvent(address indexed user, uint256 indexed timestamp, uint256 value)
+func (scratchCardV1 *ScratchCardV1) UnpackScratchCardEventEvent(log *types.Log) (*ScratchCardV1ScratchCardEvent, error) {
+	event := "ScratchCardEvent"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardV1ScratchCardEvent)
+	if len(log.Data) > 0 {
+		if err := scratchCardV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardV1Upgraded represents a Upgraded event raised by the ScratchCardV1 contract.
+type ScratchCardV1Upgraded struct {
+	Implementation common.Address
+	Raw            *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardV1UpgradedEventName = "Upgraded"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardV1Upgraded) ContractEventName() string {
+	return ScratchCardV1UpgradedEventName
+}
+
+// UnpackUpgradedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (scratchCardV1 *ScratchCardV1) UnpackUpgradedEvent(log *types.Log) (*ScratchCardV1Upgraded, error) {
+	event := "Upgraded"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardV1.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardV1Upgraded)
+	if len(log.Data) > 0 {
+		if err := scratchCardV1.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardV1.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// UnpackError attempts to decode the provided error data using user-defined
+// error definitions.
+func (scratchCardV1 *ScratchCardV1) UnpackError(raw []byte) (any, error) {
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["AddressEmptyCode"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackAddressEmptyCodeError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["ERC1967InvalidImplementation"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackERC1967InvalidImplementationError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["ERC1967NonPayable"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackERC1967NonPayableError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["FailedCall"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackFailedCallError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["InvalidInitialization"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackInvalidInitializationError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["NotInitializing"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackNotInitializingError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["OnlyRandProvider"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackOnlyRandProviderError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["OwnableInvalidOwner"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackOwnableInvalidOwnerError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["OwnableUnauthorizedAccount"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackOwnableUnauthorizedAccountError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["TransferFailed"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackTransferFailedError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["UUPSUnauthorizedCallContext"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackUUPSUnauthorizedCallContextError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["UUPSUnsupportedProxiableUUID"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackUUPSUnsupportedProxiableUUIDError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardV1.abi.Errors["WrongPrice"].ID.Bytes()[:4]) {
+		return scratchCardV1.UnpackWrongPriceError(raw[4:])
+	}
+	return nil, errors.New("Unknown error")
+}
+
+// ScratchCardV1AddressEmptyCode represents a AddressEmptyCode error raised by the ScratchCardV1 contract.
+type ScratchCardV1AddressEmptyCode struct {
+	Target common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error AddressEmptyCode(address target)
+func ScratchCardV1AddressEmptyCodeErrorID() common.Hash {
+	return common.HexToHash("0x9996b315c842ff135b8fc4a08ad5df1c344efbc03d2687aecc0678050d2aac89")
+}
+
+// UnpackAddressEmptyCodeError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error AddressEmptyCode(address target)
+func (scratchCardV1 *ScratchCardV1) UnpackAddressEmptyCodeError(raw []byte) (*ScratchCardV1AddressEmptyCode, error) {
+	out := new(ScratchCardV1AddressEmptyCode)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "AddressEmptyCode", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1ERC1967InvalidImplementation represents a ERC1967InvalidImplementation error raised by the ScratchCardV1 contract.
+type ScratchCardV1ERC1967InvalidImplementation struct {
+	Implementation common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC1967InvalidImplementation(address implementation)
+func ScratchCardV1ERC1967InvalidImplementationErrorID() common.Hash {
+	return common.HexToHash("0x4c9c8ce3ceb3130f17f7cdba48d89b5b0129f266a8bac114e6e315a41879b617")
+}
+
+// UnpackERC1967InvalidImplementationError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC1967InvalidImplementation(address implementation)
+func (scratchCardV1 *ScratchCardV1) UnpackERC1967InvalidImplementationError(raw []byte) (*ScratchCardV1ERC1967InvalidImplementation, error) {
+	out := new(ScratchCardV1ERC1967InvalidImplementation)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "ERC1967InvalidImplementation", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1ERC1967NonPayable represents a ERC1967NonPayable error raised by the ScratchCardV1 contract.
+type ScratchCardV1ERC1967NonPayable struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ERC1967NonPayable()
+func ScratchCardV1ERC1967NonPayableErrorID() common.Hash {
+	return common.HexToHash("0xb398979fa84f543c8e222f17890372c487baf85e062276c127fef521eea7224b")
+}
+
+// UnpackERC1967NonPayableError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ERC1967NonPayable()
+func (scratchCardV1 *ScratchCardV1) UnpackERC1967NonPayableError(raw []byte) (*ScratchCardV1ERC1967NonPayable, error) {
+	out := new(ScratchCardV1ERC1967NonPayable)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "ERC1967NonPayable", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1FailedCall represents a FailedCall error raised by the ScratchCardV1 contract.
+type ScratchCardV1FailedCall struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error FailedCall()
+func ScratchCardV1FailedCallErrorID() common.Hash {
+	return common.HexToHash("0xd6bda27508c0fb6d8a39b4b122878dab26f731a7d4e4abe711dd3731899052a4")
+}
+
+// UnpackFailedCallError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error FailedCall()
+func (scratchCardV1 *ScratchCardV1) UnpackFailedCallError(raw []byte) (*ScratchCardV1FailedCall, error) {
+	out := new(ScratchCardV1FailedCall)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "FailedCall", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1InvalidInitialization represents a InvalidInitialization error raised by the ScratchCardV1 contract.
+type ScratchCardV1InvalidInitialization struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error InvalidInitialization()
+func ScratchCardV1InvalidInitializationErrorID() common.Hash {
+	return common.HexToHash("0xf92ee8a957075833165f68c320933b1a1294aafc84ee6e0dd3fb178008f9aaf5")
+}
+
+// UnpackInvalidInitializationError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error InvalidInitialization()
+func (scratchCardV1 *ScratchCardV1) UnpackInvalidInitializationError(raw []byte) (*ScratchCardV1InvalidInitialization, error) {
+	out := new(ScratchCardV1InvalidInitialization)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "InvalidInitialization", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1NotInitializing represents a NotInitializing error raised by the ScratchCardV1 contract.
+type ScratchCardV1NotInitializing struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error NotInitializing()
+func ScratchCardV1NotInitializingErrorID() common.Hash {
+	return common.HexToHash("0xd7e6bcf8597daa127dc9f0048d2f08d5ef140a2cb659feabd700beff1f7a8302")
+}
+
+// UnpackNotInitializingError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error NotInitializing()
+func (scratchCardV1 *ScratchCardV1) UnpackNotInitializingError(raw []byte) (*ScratchCardV1NotInitializing, error) {
+	out := new(ScratchCardV1NotInitializing)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "NotInitializing", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1OnlyRandProvider represents a OnlyRandProvider error raised by the ScratchCardV1 contract.
+type ScratchCardV1OnlyRandProvider struct {
+	Sender common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OnlyRandProvider(address sender)
+func ScratchCardV1OnlyRandProviderErrorID() common.Hash {
+	return common.HexToHash("0x3349fd1fb14d508148b8931b3404e8787c73ff82c861755dca6ef84abbd2f047")
+}
+
+// UnpackOnlyRandProviderError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OnlyRandProvider(address sender)
+func (scratchCardV1 *ScratchCardV1) UnpackOnlyRandProviderError(raw []byte) (*ScratchCardV1OnlyRandProvider, error) {
+	out := new(ScratchCardV1OnlyRandProvider)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "OnlyRandProvider", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1OwnableInvalidOwner represents a OwnableInvalidOwner error raised by the ScratchCardV1 contract.
+type ScratchCardV1OwnableInvalidOwner struct {
+	Owner common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OwnableInvalidOwner(address owner)
+func ScratchCardV1OwnableInvalidOwnerErrorID() common.Hash {
+	return common.HexToHash("0x1e4fbdf7f3ef8bcaa855599e3abf48b232380f183f08f6f813d9ffa5bd585188")
+}
+
+// UnpackOwnableInvalidOwnerError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OwnableInvalidOwner(address owner)
+func (scratchCardV1 *ScratchCardV1) UnpackOwnableInvalidOwnerError(raw []byte) (*ScratchCardV1OwnableInvalidOwner, error) {
+	out := new(ScratchCardV1OwnableInvalidOwner)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "OwnableInvalidOwner", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1OwnableUnauthorizedAccount represents a OwnableUnauthorizedAccount error raised by the ScratchCardV1 contract.
+type ScratchCardV1OwnableUnauthorizedAccount struct {
+	Account common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OwnableUnauthorizedAccount(address account)
+func ScratchCardV1OwnableUnauthorizedAccountErrorID() common.Hash {
+	return common.HexToHash("0x118cdaa7a341953d1887a2245fd6665d741c67c8c50581daa59e1d03373fa188")
+}
+
+// UnpackOwnableUnauthorizedAccountError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OwnableUnauthorizedAccount(address account)
+func (scratchCardV1 *ScratchCardV1) UnpackOwnableUnauthorizedAccountError(raw []byte) (*ScratchCardV1OwnableUnauthorizedAccount, error) {
+	out := new(ScratchCardV1OwnableUnauthorizedAccount)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "OwnableUnauthorizedAccount", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1TransferFailed represents a TransferFailed error raised by the ScratchCardV1 contract.
+type ScratchCardV1TransferFailed struct {
+	Value *big.Int
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error TransferFailed(uint256 value)
+func ScratchCardV1TransferFailedErrorID() common.Hash {
+	return common.HexToHash("0xc39ba1a9a812115efec13c9546b7b109574823d9c12ff09ebcc4ba5d47153ec6")
+}
+
+// UnpackTransferFailedError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error TransferFailed(uint256 value)
+func (scratchCardV1 *ScratchCardV1) UnpackTransferFailedError(raw []byte) (*ScratchCardV1TransferFailed, error) {
+	out := new(ScratchCardV1TransferFailed)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "TransferFailed", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1UUPSUnauthorizedCallContext represents a UUPSUnauthorizedCallContext error raised by the ScratchCardV1 contract.
+type ScratchCardV1UUPSUnauthorizedCallContext struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error UUPSUnauthorizedCallContext()
+func ScratchCardV1UUPSUnauthorizedCallContextErrorID() common.Hash {
+	return common.HexToHash("0xe07c8dba242a06571ac65fe4bbe20522c9fb111cb33599b799ff8039c1ed18f4")
+}
+
+// UnpackUUPSUnauthorizedCallContextError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error UUPSUnauthorizedCallContext()
+func (scratchCardV1 *ScratchCardV1) UnpackUUPSUnauthorizedCallContextError(raw []byte) (*ScratchCardV1UUPSUnauthorizedCallContext, error) {
+	out := new(ScratchCardV1UUPSUnauthorizedCallContext)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "UUPSUnauthorizedCallContext", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1UUPSUnsupportedProxiableUUID represents a UUPSUnsupportedProxiableUUID error raised by the ScratchCardV1 contract.
+type ScratchCardV1UUPSUnsupportedProxiableUUID struct {
+	Slot [32]byte
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error UUPSUnsupportedProxiableUUID(bytes32 slot)
+func ScratchCardV1UUPSUnsupportedProxiableUUIDErrorID() common.Hash {
+	return common.HexToHash("0xaa1d49a4c084bfa9aeeee2a0be65267a7f19ba7e1476b114dac513d2c14cb563")
+}
+
+// UnpackUUPSUnsupportedProxiableUUIDError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error UUPSUnsupportedProxiableUUID(bytes32 slot)
+func (scratchCardV1 *ScratchCardV1) UnpackUUPSUnsupportedProxiableUUIDError(raw []byte) (*ScratchCardV1UUPSUnsupportedProxiableUUID, error) {
+	out := new(ScratchCardV1UUPSUnsupportedProxiableUUID)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "UUPSUnsupportedProxiableUUID", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardV1WrongPrice represents a WrongPrice error raised by the ScratchCardV1 contract.
+type ScratchCardV1WrongPrice struct {
+	Value *big.Int
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error WrongPrice(uint256 value)
+func ScratchCardV1WrongPriceErrorID() common.Hash {
+	return common.HexToHash("0x514817201513709ebd9c414919849e1b72df0322a824481018c1ba89f6a63718")
+}
+
+// UnpackWrongPriceError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error WrongPrice(uint256 value)
+func (scratchCardV1 *ScratchCardV1) UnpackWrongPriceError(raw []byte) (*ScratchCardV1WrongPrice, error) {
+	out := new(ScratchCardV1WrongPrice)
+	if err := scratchCardV1.abi.UnpackIntoInterface(out, "WrongPrice", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
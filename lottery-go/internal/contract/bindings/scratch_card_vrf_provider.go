@@ -0,0 +1,804 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// ScratchCardVRFProviderMetaData contains all meta data concerning the ScratchCardVRFProvider contract.
+var ScratchCardVRFProviderMetaData = bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"vrfCoordinator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_subId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_keyHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"acceptOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"callback\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIScratchCardRandCallback\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"callbackGasLimit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"keyHash\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"rawFulfillRandomWords\",\"inputs\":[{\"name\":\"requestId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"randomWords\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"requestConfirmations\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"requestRandomNumbers\",\"inputs\":[{\"name\":\"_user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"s_vrfCoordinator\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIVRFCoordinatorV2Plus\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setCallbackAddress\",\"inputs\":[{\"name\":\"_callbackAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setCallbackGasLimit\",\"inputs\":[{\"name\":\"_callbackGasLimit\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setCoordinator\",\"inputs\":[{\"name\":\"_vrfCoordinator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setKeyHash\",\"inputs\":[{\"name\":\"_keyHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setRequestConfirmations\",\"inputs\":[{\"name\":\"_requestConfirmations\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setSubId\",\"inputs\":[{\"name\":\"_subId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"subId\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"vrfRequestIds\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"CoordinatorSet\",\"inputs\":[{\"name\":\"vrfCoordinator\",\"type\":\"address\",\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferRequested\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"OnlyCoordinatorCanFulfill\",\"inputs\":[{\"name\":\"have\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"want\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OnlyOwnerOrCoordinator\",\"inputs\":[{\"name\":\"have\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"coordinator\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"VRFRequestFailed\",\"inputs\":[{\"name\":\"requestId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ZeroAddress\",\"inputs\":[]}]",
+	ID:  "ScratchCardVRFProvider",
+}
+
+// ScratchCardVRFProvider is an auto generated Go binding around an Ethereum contract.
+type ScratchCardVRFProvider struct {
+	abi abi.ABI
+}
+
+// NewScratchCardVRFProvider creates a new instance of ScratchCardVRFProvider.
+func NewScratchCardVRFProvider() *ScratchCardVRFProvider {
+	parsed, err := ScratchCardVRFProviderMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &ScratchCardVRFProvider{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *ScratchCardVRFProvider) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackConstructor is the Go binding used to pack the parameters required for
+// contract deployment.
+//
+// Solidity: constructor(address vrfCoordinator, uint256 _subId, bytes32 _keyHash) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackConstructor(vrfCoordinator common.Address, _subId *big.Int, _keyHash [32]byte) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("", vrfCoordinator, _subId, _keyHash)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackAcceptOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x79ba5097.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function acceptOwnership() returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackAcceptOwnership() []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("acceptOwnership")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackAcceptOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x79ba5097.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function acceptOwnership() returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackAcceptOwnership() ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("acceptOwnership")
+}
+
+// PackCallback is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x083b2732.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function callback() view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackCallback() []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("callback")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackCallback is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x083b2732.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function callback() view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackCallback() ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("callback")
+}
+
+// UnpackCallback is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x083b2732.
+//
+// Solidity: function callback() view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackCallback(data []byte) (common.Address, error) {
+	out, err := scratchCardVRFProvider.abi.Unpack("callback", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackCallbackGasLimit is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x24f74697.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function callbackGasLimit() view returns(uint32)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackCallbackGasLimit() []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("callbackGasLimit")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackCallbackGasLimit is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x24f74697.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function callbackGasLimit() view returns(uint32)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackCallbackGasLimit() ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("callbackGasLimit")
+}
+
+// UnpackCallbackGasLimit is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x24f74697.
+//
+// Solidity: function callbackGasLimit() view returns(uint32)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackCallbackGasLimit(data []byte) (uint32, error) {
+	out, err := scratchCardVRFProvider.abi.Unpack("callbackGasLimit", data)
+	if err != nil {
+		return *new(uint32), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+	return out0, nil
+}
+
+// PackKeyHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x61728f39.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function keyHash() view returns(bytes32)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackKeyHash() []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("keyHash")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackKeyHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x61728f39.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function keyHash() view returns(bytes32)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackKeyHash() ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("keyHash")
+}
+
+// UnpackKeyHash is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x61728f39.
+//
+// Solidity: function keyHash() view returns(bytes32)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackKeyHash(data []byte) ([32]byte, error) {
+	out, err := scratchCardVRFProvider.abi.Unpack("keyHash", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, nil
+}
+
+// PackOwner is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8da5cb5b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function owner() view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackOwner() []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("owner")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackOwner is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8da5cb5b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function owner() view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackOwner() ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("owner")
+}
+
+// UnpackOwner is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackOwner(data []byte) (common.Address, error) {
+	out, err := scratchCardVRFProvider.abi.Unpack("owner", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackRawFulfillRandomWords is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x1fe543e3.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function rawFulfillRandomWords(uint256 requestId, uint256[] randomWords) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackRawFulfillRandomWords(requestId *big.Int, randomWords []*big.Int) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("rawFulfillRandomWords", requestId, randomWords)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRawFulfillRandomWords is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x1fe543e3.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function rawFulfillRandomWords(uint256 requestId, uint256[] randomWords) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackRawFulfillRandomWords(requestId *big.Int, randomWords []*big.Int) ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("rawFulfillRandomWords", requestId, randomWords)
+}
+
+// PackRequestConfirmations is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb0fb162f.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function requestConfirmations() view returns(uint16)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackRequestConfirmations() []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("requestConfirmations")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRequestConfirmations is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb0fb162f.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function requestConfirmations() view returns(uint16)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackRequestConfirmations() ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("requestConfirmations")
+}
+
+// UnpackRequestConfirmations is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xb0fb162f.
+//
+// Solidity: function requestConfirmations() view returns(uint16)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackRequestConfirmations(data []byte) (uint16, error) {
+	out, err := scratchCardVRFProvider.abi.Unpack("requestConfirmations", data)
+	if err != nil {
+		return *new(uint16), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+	return out0, nil
+}
+
+// PackRequestRandomNumbers is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x59f94d7f.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function requestRandomNumbers(address _user) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackRequestRandomNumbers(user common.Address) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("requestRandomNumbers", user)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRequestRandomNumbers is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x59f94d7f.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function requestRandomNumbers(address _user) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackRequestRandomNumbers(user common.Address) ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("requestRandomNumbers", user)
+}
+
+// PackSVrfCoordinator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x9eccacf6.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function s_vrfCoordinator() view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackSVrfCoordinator() []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("s_vrfCoordinator")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSVrfCoordinator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x9eccacf6.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function s_vrfCoordinator() view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackSVrfCoordinator() ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("s_vrfCoordinator")
+}
+
+// UnpackSVrfCoordinator is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x9eccacf6.
+//
+// Solidity: function s_vrfCoordinator() view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackSVrfCoordinator(data []byte) (common.Address, error) {
+	out, err := scratchCardVRFProvider.abi.Unpack("s_vrfCoordinator", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// PackSetCallbackAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x37881810.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setCallbackAddress(address _callbackAddress) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackSetCallbackAddress(callbackAddress common.Address) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("setCallbackAddress", callbackAddress)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetCallbackAddress is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x37881810.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setCallbackAddress(address _callbackAddress) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackSetCallbackAddress(callbackAddress common.Address) ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("setCallbackAddress", callbackAddress)
+}
+
+// PackSetCallbackGasLimit is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa4eb718c.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setCallbackGasLimit(uint32 _callbackGasLimit) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackSetCallbackGasLimit(callbackGasLimit uint32) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("setCallbackGasLimit", callbackGasLimit)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetCallbackGasLimit is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa4eb718c.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setCallbackGasLimit(uint32 _callbackGasLimit) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackSetCallbackGasLimit(callbackGasLimit uint32) ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("setCallbackGasLimit", callbackGasLimit)
+}
+
+// PackSetCoordinator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8ea98117.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setCoordinator(address _vrfCoordinator) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackSetCoordinator(vrfCoordinator common.Address) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("setCoordinator", vrfCoordinator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetCoordinator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8ea98117.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setCoordinator(address _vrfCoordinator) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackSetCoordinator(vrfCoordinator common.Address) ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("setCoordinator", vrfCoordinator)
+}
+
+// PackSetKeyHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x98544710.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setKeyHash(bytes32 _keyHash) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackSetKeyHash(keyHash [32]byte) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("setKeyHash", keyHash)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetKeyHash is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x98544710.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setKeyHash(bytes32 _keyHash) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackSetKeyHash(keyHash [32]byte) ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("setKeyHash", keyHash)
+}
+
+// PackSetRequestConfirmations is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8824f5a7.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setRequestConfirmations(uint16 _requestConfirmations) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackSetRequestConfirmations(requestConfirmations uint16) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("setRequestConfirmations", requestConfirmations)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetRequestConfirmations is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8824f5a7.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setRequestConfirmations(uint16 _requestConfirmations) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackSetRequestConfirmations(requestConfirmations uint16) ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("setRequestConfirmations", requestConfirmations)
+}
+
+// PackSetSubId is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x80980043.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setSubId(uint256 _subId) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackSetSubId(subId *big.Int) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("setSubId", subId)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetSubId is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x80980043.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setSubId(uint256 _subId) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackSetSubId(subId *big.Int) ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("setSubId", subId)
+}
+
+// PackSubId is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xeb1d28bb.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function subId() view returns(uint256)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackSubId() []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("subId")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSubId is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xeb1d28bb.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function subId() view returns(uint256)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackSubId() ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("subId")
+}
+
+// UnpackSubId is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xeb1d28bb.
+//
+// Solidity: function subId() view returns(uint256)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackSubId(data []byte) (*big.Int, error) {
+	out, err := scratchCardVRFProvider.abi.Unpack("subId", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackTransferOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf2fde38b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function transferOwnership(address to) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackTransferOwnership(to common.Address) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("transferOwnership", to)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackTransferOwnership is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf2fde38b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function transferOwnership(address to) returns()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackTransferOwnership(to common.Address) ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("transferOwnership", to)
+}
+
+// PackVrfRequestIds is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x425c9fa2.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function vrfRequestIds(uint256 ) view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) PackVrfRequestIds(arg0 *big.Int) []byte {
+	enc, err := scratchCardVRFProvider.abi.Pack("vrfRequestIds", arg0)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackVrfRequestIds is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x425c9fa2.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function vrfRequestIds(uint256 ) view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) TryPackVrfRequestIds(arg0 *big.Int) ([]byte, error) {
+	return scratchCardVRFProvider.abi.Pack("vrfRequestIds", arg0)
+}
+
+// UnpackVrfRequestIds is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x425c9fa2.
+//
+// Solidity: function vrfRequestIds(uint256 ) view returns(address)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackVrfRequestIds(data []byte) (common.Address, error) {
+	out, err := scratchCardVRFProvider.abi.Unpack("vrfRequestIds", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, nil
+}
+
+// ScratchCardVRFProviderCoordinatorSet represents a CoordinatorSet event raised by the ScratchCardVRFProvider contract.
+type ScratchCardVRFProviderCoordinatorSet struct {
+	VrfCoordinator common.Address
+	Raw            *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardVRFProviderCoordinatorSetEventName = "CoordinatorSet"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardVRFProviderCoordinatorSet) ContractEventName() string {
+	return ScratchCardVRFProviderCoordinatorSetEventName
+}
+
+// UnpackCoordinatorSetEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event CoordinatorSet(address vrfCoordinator)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackCoordinatorSetEvent(log *types.Log) (*ScratchCardVRFProviderCoordinatorSet, error) {
+	event := "CoordinatorSet"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardVRFProvider.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardVRFProviderCoordinatorSet)
+	if len(log.Data) > 0 {
+		if err := scratchCardVRFProvider.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardVRFProvider.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardVRFProviderOwnershipTransferRequested represents a OwnershipTransferRequested event raised by the ScratchCardVRFProvider contract.
+type ScratchCardVRFProviderOwnershipTransferRequested struct {
+	From common.Address
+	To   common.Address
+	Raw  *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardVRFProviderOwnershipTransferRequestedEventName = "OwnershipTransferRequested"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardVRFProviderOwnershipTransferRequested) ContractEventName() string {
+	return ScratchCardVRFProviderOwnershipTransferRequestedEventName
+}
+
+// UnpackOwnershipTransferRequestedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event OwnershipTransferRequested(address indexed from, address indexed to)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackOwnershipTransferRequestedEvent(log *types.Log) (*ScratchCardVRFProviderOwnershipTransferRequested, error) {
+	event := "OwnershipTransferRequested"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardVRFProvider.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardVRFProviderOwnershipTransferRequested)
+	if len(log.Data) > 0 {
+		if err := scratchCardVRFProvider.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardVRFProvider.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ScratchCardVRFProviderOwnershipTransferred represents a OwnershipTransferred event raised by the ScratchCardVRFProvider contract.
+type ScratchCardVRFProviderOwnershipTransferred struct {
+	From common.Address
+	To   common.Address
+	Raw  *types.Log // Blockchain specific contextual infos
+}
+
+const ScratchCardVRFProviderOwnershipTransferredEventName = "OwnershipTransferred"
+
+// ContractEventName returns the user-defined event name.
+func (ScratchCardVRFProviderOwnershipTransferred) ContractEventName() string {
+	return ScratchCardVRFProviderOwnershipTransferredEventName
+}
+
+// UnpackOwnershipTransferredEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event OwnershipTransferred(address indexed from, address indexed to)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackOwnershipTransferredEvent(log *types.Log) (*ScratchCardVRFProviderOwnershipTransferred, error) {
+	event := "OwnershipTransferred"
+	if len(log.Topics) == 0 || log.Topics[0] != scratchCardVRFProvider.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ScratchCardVRFProviderOwnershipTransferred)
+	if len(log.Data) > 0 {
+		if err := scratchCardVRFProvider.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range scratchCardVRFProvider.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// UnpackError attempts to decode the provided error data using user-defined
+// error definitions.
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackError(raw []byte) (any, error) {
+	if bytes.Equal(raw[:4], scratchCardVRFProvider.abi.Errors["OnlyCoordinatorCanFulfill"].ID.Bytes()[:4]) {
+		return scratchCardVRFProvider.UnpackOnlyCoordinatorCanFulfillError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardVRFProvider.abi.Errors["OnlyOwnerOrCoordinator"].ID.Bytes()[:4]) {
+		return scratchCardVRFProvider.UnpackOnlyOwnerOrCoordinatorError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardVRFProvider.abi.Errors["VRFRequestFailed"].ID.Bytes()[:4]) {
+		return scratchCardVRFProvider.UnpackVRFRequestFailedError(raw[4:])
+	}
+	if bytes.Equal(raw[:4], scratchCardVRFProvider.abi.Errors["ZeroAddress"].ID.Bytes()[:4]) {
+		return scratchCardVRFProvider.UnpackZeroAddressError(raw[4:])
+	}
+	return nil, errors.New("Unknown error")
+}
+
+// ScratchCardVRFProviderOnlyCoordinatorCanFulfill represents a OnlyCoordinatorCanFulfill error raised by the ScratchCardVRFProvider contract.
+type ScratchCardVRFProviderOnlyCoordinatorCanFulfill struct {
+	Have common.Address
+	Want common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OnlyCoordinatorCanFulfill(address have, address want)
+func ScratchCardVRFProviderOnlyCoordinatorCanFulfillErrorID() common.Hash {
+	return common.HexToHash("0x1cf993f4855ca2a758bea0e0e264b2cd16369bc2acc356b4ef6323767def33d8")
+}
+
+// UnpackOnlyCoordinatorCanFulfillError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OnlyCoordinatorCanFulfill(address have, address want)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackOnlyCoordinatorCanFulfillError(raw []byte) (*ScratchCardVRFProviderOnlyCoordinatorCanFulfill, error) {
+	out := new(ScratchCardVRFProviderOnlyCoordinatorCanFulfill)
+	if err := scratchCardVRFProvider.abi.UnpackIntoInterface(out, "OnlyCoordinatorCanFulfill", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardVRFProviderOnlyOwnerOrCoordinator represents a OnlyOwnerOrCoordinator error raised by the ScratchCardVRFProvider contract.
+type ScratchCardVRFProviderOnlyOwnerOrCoordinator struct {
+	Have        common.Address
+	Owner       common.Address
+	Coordinator common.Address
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error OnlyOwnerOrCoordinator(address have, address owner, address coordinator)
+func ScratchCardVRFProviderOnlyOwnerOrCoordinatorErrorID() common.Hash {
+	return common.HexToHash("0x061db9c18b2171ebdcc49c56bb45998700c8e30d0757c65e99e8734441cf0174")
+}
+
+// UnpackOnlyOwnerOrCoordinatorError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error OnlyOwnerOrCoordinator(address have, address owner, address coordinator)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackOnlyOwnerOrCoordinatorError(raw []byte) (*ScratchCardVRFProviderOnlyOwnerOrCoordinator, error) {
+	out := new(ScratchCardVRFProviderOnlyOwnerOrCoordinator)
+	if err := scratchCardVRFProvider.abi.UnpackIntoInterface(out, "OnlyOwnerOrCoordinator", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardVRFProviderVRFRequestFailed represents a VRFRequestFailed error raised by the ScratchCardVRFProvider contract.
+type ScratchCardVRFProviderVRFRequestFailed struct {
+	RequestId *big.Int
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error VRFRequestFailed(uint256 requestId)
+func ScratchCardVRFProviderVRFRequestFailedErrorID() common.Hash {
+	return common.HexToHash("0xdfa01c3638006fa6d3cbf4a432d450e3faf2ed6dabb7475560943003c7d1574c")
+}
+
+// UnpackVRFRequestFailedError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error VRFRequestFailed(uint256 requestId)
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackVRFRequestFailedError(raw []byte) (*ScratchCardVRFProviderVRFRequestFailed, error) {
+	out := new(ScratchCardVRFProviderVRFRequestFailed)
+	if err := scratchCardVRFProvider.abi.UnpackIntoInterface(out, "VRFRequestFailed", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScratchCardVRFProviderZeroAddress represents a ZeroAddress error raised by the ScratchCardVRFProvider contract.
+type ScratchCardVRFProviderZeroAddress struct {
+}
+
+// ErrorID returns the hash of canonical representation of the error's signature.
+//
+// Solidity: error ZeroAddress()
+func ScratchCardVRFProviderZeroAddressErrorID() common.Hash {
+	return common.HexToHash("0xd92e233df2717d4a40030e20904abd27b68fcbeede117eaaccbbdac9618c8c73")
+}
+
+// UnpackZeroAddressError is the Go binding used to decode the provided
+// error data into the corresponding Go error struct.
+//
+// Solidity: error ZeroAddress()
+func (scratchCardVRFProvider *ScratchCardVRFProvider) UnpackZeroAddressError(raw []byte) (*ScratchCardVRFProviderZeroAddress, error) {
+	out := new(ScratchCardVRFProviderZeroAddress)
+	if err := scratchCardVRFProvider.abi.UnpackIntoInterface(out, "ZeroAddress", raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,111 @@
+package contract
+
+import (
+	"context"
+
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/base/lifecycle"
+	"lottery-go/internal/base/logx"
+	"lottery-go/internal/config"
+	"lottery-go/internal/pkg/eth"
+)
+
+const (
+	txJournalBolt   = "bolt"
+	txJournalMemory = "memory"
+)
+
+// NewEthClient 创建合约层共享的RPC客户端，进程退出时关闭连接。
+// 启动时核对交易日志，恢复进程崩溃前未确认的交易。
+func NewEthClient(lc *lifecycle.Lifecycle) (*eth.Client, func(), error) {
+	journal, err := newTxJournal(config.GetTxJournal())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conf := config.DailyLottery()
+	client, err := eth.NewClient(eth.Options{
+		Endpoints: endpoints(conf),
+		Timeouts: eth.Timeouts{
+			Call:    conf.Timeout.Call,
+			Send:    conf.Timeout.Send,
+			Confirm: conf.Timeout.Confirm,
+		},
+		HealthCheck: eth.HealthCheck{
+			Interval:     conf.HealthCheck.Interval,
+			MaxBlockLag:  conf.HealthCheck.MaxBlockLag,
+			MaxErrorRate: conf.HealthCheck.MaxErrorRate,
+		},
+		Journal:       journal,
+		Confirmations: conf.Confirmations,
+	})
+	if err != nil {
+		_ = journal.Close()
+		return nil, nil, err
+	}
+
+	// 核对会重发交易，启动自检（lifecycle 启动检查）通过后才执行；
+	// 核对失败不影响启动，发送相同调用前仍会检查交易日志
+	lc.Append(lifecycle.Hook{Name: "txJournal", OnStart: func(ctx context.Context) error {
+		if err := client.ReconcileJournal(ctx); err != nil {
+			logx.ErrorF("failed to reconcile tx journal. %v", err)
+		}
+		return nil
+	}})
+
+	cleanup := func() {
+		client.Close()
+		_ = journal.Close()
+	}
+	return client, cleanup, nil
+}
+
+// newTxJournal 根据配置创建交易日志存储
+func newTxJournal(conf config.TxJournal) (eth.TxJournal, error) {
+	switch conf.Type {
+	case txJournalMemory:
+		return eth.NewMemoryTxJournal(), nil
+	case txJournalBolt:
+		return eth.NewBoltTxJournal(conf.Path)
+	default:
+		return nil, errorx.New("unknown tx journal type", "type", conf.Type)
+	}
+}
+
+// endpoints 合约配置的RPC节点列表，未配置时使用单个rpcUrl
+func endpoints(conf *config.Contract) []eth.Endpoint {
+	if len(conf.RpcEndpoints) == 0 {
+		if conf.RpcUrl == "" {
+			return nil
+		}
+		return []eth.Endpoint{{Url: conf.RpcUrl}}
+	}
+
+	list := make([]eth.Endpoint, 0, len(conf.RpcEndpoints))
+	for _, ep := range conf.RpcEndpoints {
+		list = append(list, eth.Endpoint{Url: ep.Url, Priority: ep.Priority})
+	}
+	return list
+}
+
+// newGasPricer 根据合约配置创建gas定价策略
+func newGasPricer(conf config.Gas) eth.GasPricer {
+	return eth.NewFeeHistoryPricer(eth.GasPolicy{
+		Legacy:            conf.Legacy,
+		FeeHistoryBlocks:  conf.FeeHistoryBlocks,
+		TipPercentile:     conf.TipPercentile,
+		MinTipGwei:        conf.MinTipGwei,
+		BaseFeeMultiplier: conf.BaseFeeMultiplier,
+		MaxFeeGwei:        conf.MaxFeeGwei,
+		GasLimitMargin:    conf.GasLimitMargin,
+	})
+}
+
+// replacePolicy 根据合约配置创建未确认交易的加速策略
+func replacePolicy(conf config.Gas) eth.ReplacePolicy {
+	return eth.ReplacePolicy{
+		AfterBlocks: conf.ReplaceAfterBlocks,
+		BumpPercent: conf.BumpPercent,
+		MaxFeeGwei:  conf.MaxFeeGwei,
+	}
+}
@@ -2,12 +2,21 @@
 package contract
 
 import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/base/errorx"
 	"lottery-go/internal/config"
 	"lottery-go/internal/pkg/eth"
 )
 
 type DailyLotteryContract struct {
-	config *config.Contract
+	config    *config.Contract
+	client    *eth.Client
+	signer    eth.Signer
+	gasPricer eth.GasPricer
 }
 
 type DrawState uint8
@@ -18,71 +27,130 @@ const (
 	Drawn
 )
 
+func (state DrawState) String() string {
+	switch state {
+	case NotDrawn:
+		return "NotDrawn"
+	case Drawing:
+		return "Drawing"
+	case Drawn:
+		return "Drawn"
+	}
+	return "Unknown"
+}
+
 var drawStates = map[uint8]DrawState{
 	0: NotDrawn,
 	1: Drawing,
 	2: Drawn,
 }
 
-func NewDailyLotteryContract() *DailyLotteryContract {
-	return &DailyLotteryContract{config: config.DailyLottery()}
+func NewDailyLotteryContract(client *eth.Client, signer eth.Signer) *DailyLotteryContract {
+	conf := config.DailyLottery()
+	return &DailyLotteryContract{config: conf, client: client, signer: signer, gasPricer: newGasPricer(conf.Gas)}
+}
+
+func (contract *DailyLotteryContract) address() common.Address {
+	return common.HexToAddress(contract.config.Address)
 }
 
 // LotteryNumber current lottery number
-func (contract *DailyLotteryContract) LotteryNumber() (uint64, error) {
-	var lotteryNumber uint64
-	err := eth.CallContractView(&eth.CallContext{
-		RpcUrl:   contract.config.RpcUrl,
-		Address:  contract.config.Address,
-		Abi:      dailyLotteryContractABI,
-		FuncName: "lotteryNumber",
-	}, &lotteryNumber)
-	if err != nil {
-		return 0, err
-	}
-	return lotteryNumber, nil
+func (contract *DailyLotteryContract) LotteryNumber(ctx context.Context) (uint64, error) {
+	return callView(ctx, contract.client, contract.address(), "lotteryNumber",
+		dailyLottery.PackLotteryNumber(), dailyLottery.UnpackLotteryNumber)
 }
 
 // DrawState 获取开奖状态
-func (contract *DailyLotteryContract) DrawState(lotteryNumber uint64) (DrawState, error) {
-	var drawState uint8
-	err := eth.CallContractView(&eth.CallContext{
-		RpcUrl:   contract.config.RpcUrl,
-		Address:  contract.config.Address,
-		Abi:      dailyLotteryContractABI,
-		FuncName: "getDrawState",
-	}, &drawState, lotteryNumber)
+func (contract *DailyLotteryContract) DrawState(ctx context.Context, lotteryNumber uint64) (DrawState, error) {
+	drawState, err := callView(ctx, contract.client, contract.address(), "getDrawState",
+		dailyLottery.PackGetDrawState(lotteryNumber), dailyLottery.UnpackGetDrawState)
 	if err != nil {
 		return 0, err
 	}
 	return drawStates[drawState], nil
 }
 
-// Draw 执行抽奖交易
-func (contract *DailyLotteryContract) Draw(lotteryNumber uint64) error {
-	_, err := eth.SendTransaction(&eth.TransactionContext{
-		RpcUrl:     contract.config.RpcUrl,
-		Address:    contract.config.Address,
-		Abi:        dailyLotteryContractABI,
-		FuncName:   "drawLottery",
-		PrivateKey: contract.config.PrivateKey,
-	}, lotteryNumber)
+// Draw 执行抽奖交易，返回交易达到确认数后的结果。executor 模式下通过执行合约转发，revert数据由执行合约冒泡
+func (contract *DailyLotteryContract) Draw(ctx context.Context, lotteryNumber uint64) (*eth.TxResult, error) {
+	to, data, err := contract.drawCall(lotteryNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := contract.client.SendTransaction(ctx, &eth.TransactionContext{
+		Address:        to,
+		FuncName:       "drawLottery",
+		Data:           data,
+		Signer:         contract.signer,
+		GasPricer:      contract.gasPricer,
+		GasLimitMargin: contract.config.Gas.GasLimitMargin,
+		Replace:        replacePolicy(contract.config.Gas),
+		Simulate:       contract.config.Simulate,
+		ErrorAbi:       dailyLotteryErrorABI,
+	})
 
 	if err != nil {
-		// 检查是否是合约错误
+		// 检查是否是合约错误，模拟执行时已解析
 		if contractErr := eth.ParseContractError(dailyLotteryErrorABI, err); contractErr != nil {
-			return contractErr
+			return nil, contract.newDailyLotteryError(ctx, contractErr)
 		}
-		return err
+		// 交易已广播时返回交易哈希，供调用方记录
+		return result, err
 	}
-	return nil
+	return result, nil
+}
+
+// MinDrawInterval 两期开奖的最小间隔
+func (contract *DailyLotteryContract) MinDrawInterval(ctx context.Context) (time.Duration, error) {
+	interval, err := callView(ctx, contract.client, contract.address(), "minDrawInterval",
+		dailyLottery.PackMinDrawInterval(), dailyLottery.UnpackMinDrawInterval)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(interval) * time.Second, nil
 }
 
 // IsDrawn 检查是否已抽奖完成，供application层使用
-func (contract *DailyLotteryContract) IsDrawn(lotteryNumber uint64) bool {
-	drawState, err := contract.DrawState(lotteryNumber)
+func (contract *DailyLotteryContract) IsDrawn(ctx context.Context, lotteryNumber uint64) bool {
+	drawState, err := contract.DrawState(ctx, lotteryNumber)
 	if err != nil {
 		return false
 	}
 	return drawState > 0
 }
+
+// Operator 发送开奖交易的账户地址
+func (contract *DailyLotteryContract) Operator() (common.Address, error) {
+	if contract.signer == nil {
+		return common.Address{}, errorx.New("signer is not configured")
+	}
+	return contract.signer.Address(), nil
+}
+
+// OperatorBalance 发送开奖交易账户的余额（wei）
+func (contract *DailyLotteryContract) OperatorBalance(ctx context.Context) (common.Address, *big.Int, error) {
+	operator, err := contract.Operator()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	balance, err := contract.client.BalanceAt(ctx, operator)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return operator, balance, nil
+}
+
+// CancelPending 取消发送开奖交易账户的未确认交易，nonce 为nil时取消最早的一笔，返回取消交易的哈希
+func (contract *DailyLotteryContract) CancelPending(ctx context.Context, nonce *uint64) (string, error) {
+	tx, err := contract.client.CancelTransaction(ctx, &eth.TransactionContext{
+		FuncName:  "cancel",
+		Signer:    contract.signer,
+		GasPricer: contract.gasPricer,
+		Replace:   replacePolicy(contract.config.Gas),
+	}, nonce)
+	if err != nil {
+		return "", err
+	}
+	return tx.Hash().Hex(), nil
+}
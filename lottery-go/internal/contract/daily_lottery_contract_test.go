@@ -1,9 +1,13 @@
 package contract
 
 import (
+	"context"
 	"lottery-go/internal/config"
 	"lottery-go/internal/pkg/eth"
 	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var (
@@ -20,9 +24,25 @@ func DailyLotteryContractConfig() *config.Contract {
 	}
 }
 
+func newTestDailyLotteryContract(t *testing.T) *DailyLotteryContract {
+	client, err := eth.NewClient(eth.Options{Endpoints: endpoints(DailyLotteryContractConfig())})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	t.Cleanup(client.Close)
+
+	contract := &DailyLotteryContract{config: DailyLotteryContractConfig(), client: client}
+	if privateKey != "" {
+		if contract.signer, err = eth.NewHexKeySigner(privateKey); err != nil {
+			t.Fatalf("fails to NewHexKeySigner(), %v", err)
+		}
+	}
+	return contract
+}
+
 func TestDailyLotteryContract_LotteryNumber(t *testing.T) {
-	contract := &DailyLotteryContract{config: DailyLotteryContractConfig()}
-	lotteryNumber, err := contract.LotteryNumber()
+	contract := newTestDailyLotteryContract(t)
+	lotteryNumber, err := contract.LotteryNumber(context.Background())
 	if err != nil {
 		t.Fatalf("fails to LotteryNumber(), %v", err)
 	}
@@ -31,10 +51,10 @@ func TestDailyLotteryContract_LotteryNumber(t *testing.T) {
 }
 
 func TestDailyLotteryContract_DrawState(t *testing.T) {
-	contract := &DailyLotteryContract{config: DailyLotteryContractConfig()}
+	contract := newTestDailyLotteryContract(t)
 
 	// 使用彩票号码获取抽奖状态
-	drawState, err := contract.DrawState(1)
+	drawState, err := contract.DrawState(context.Background(), 1)
 	if err != nil {
 		t.Fatalf("fails to get DrawState(), %v", err)
 	}
@@ -43,16 +63,16 @@ func TestDailyLotteryContract_DrawState(t *testing.T) {
 }
 
 func TestDailyLotteryContract_Draw(t *testing.T) {
-	contract := &DailyLotteryContract{config: DailyLotteryContractConfig()}
+	contract := newTestDailyLotteryContract(t)
 
 	// 获取lotteryNumber
-	lotteryNumber, err := contract.LotteryNumber()
+	lotteryNumber, err := contract.LotteryNumber(context.Background())
 	if err != nil {
 		t.Fatalf("fails to LotteryNumber(), %v", err)
 	}
 
 	// 开奖
-	err = contract.Draw(lotteryNumber)
+	_, err = contract.Draw(context.Background(), lotteryNumber)
 	if err != nil {
 		t.Logf("Draw() error: %v", err)
 
@@ -68,3 +88,27 @@ func TestDailyLotteryContract_Draw(t *testing.T) {
 		}
 	}
 }
+
+func TestDailyLotteryContract_Bindings(t *testing.T) {
+	owner := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	contract := newMockContract(t, map[string][]byte{
+		selector(dailyLottery.PackLotteryNumber()):   uint256Result(7),
+		selector(dailyLottery.PackGetDrawState(7)):   uint256Result(int64(Drawing)),
+		selector(dailyLottery.PackOwner()):           common.LeftPadBytes(owner.Bytes(), 32),
+		selector(dailyLottery.PackMinDrawInterval()): uint256Result(3600),
+	})
+	ctx := context.Background()
+
+	if lotteryNumber, err := contract.LotteryNumber(ctx); err != nil || lotteryNumber != 7 {
+		t.Fatalf("LotteryNumber() = %d, %v", lotteryNumber, err)
+	}
+	if state, err := contract.DrawState(ctx, 7); err != nil || state != Drawing {
+		t.Fatalf("DrawState() = %s, %v", state, err)
+	}
+	if actual, err := contract.Owner(ctx); err != nil || actual != owner {
+		t.Fatalf("Owner() = %s, %v", actual.Hex(), err)
+	}
+	if interval, err := contract.MinDrawInterval(ctx); err != nil || interval != time.Hour {
+		t.Fatalf("MinDrawInterval() = %s, %v", interval, err)
+	}
+}
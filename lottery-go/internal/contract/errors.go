@@ -0,0 +1,173 @@
+package contract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/pkg/eth"
+)
+
+// revertError 合约revert错误的公共部分，Unwrap 返回解析后的 *eth.ContractError
+type revertError struct {
+	contractErr *eth.ContractError
+	message     string
+}
+
+func (e *revertError) Error() string {
+	return e.message
+}
+
+func (e *revertError) Unwrap() error {
+	return e.contractErr
+}
+
+func newRevertError(contractErr *eth.ContractError, message string) revertError {
+	return revertError{contractErr: contractErr, message: message}
+}
+
+// WrongEthValueError 购买时支付的ETH与价格不符
+type WrongEthValueError struct {
+	revertError
+	Value *big.Int
+}
+
+// NoNumbersToDrawError 本期没有可开奖的号码
+type NoNumbersToDrawError struct {
+	revertError
+}
+
+// WrongLotteryNumberError 开奖期号不是当前期号
+type WrongLotteryNumberError struct {
+	revertError
+	Param   uint64
+	Current uint64
+}
+
+// DrawingInProgressError 本期已在开奖中，等待随机数回调
+type DrawingInProgressError struct {
+	revertError
+}
+
+// MinDrawIntervalNotMetError 距上期开奖未达到最小间隔
+type MinDrawIntervalNotMetError struct {
+	revertError
+	StartTime   time.Time
+	CurrentTime time.Time
+	AllowedAt   time.Time // 允许开奖的时间，读取最小间隔失败时为零值
+}
+
+// TransferFailedError 转账失败
+type TransferFailedError struct {
+	revertError
+	Value *big.Int
+}
+
+// OnlyRandProviderError 非随机数提供合约调用了回调函数
+type OnlyRandProviderError struct {
+	revertError
+	Sender common.Address
+}
+
+// OwnableUnauthorizedAccountError 发送交易的账户不是合约owner
+type OwnableUnauthorizedAccountError struct {
+	revertError
+	Account common.Address
+}
+
+// VRFRequestFailedError VRF provider 请求随机数失败
+type VRFRequestFailedError struct {
+	revertError
+}
+
+// VRFRequestAlreadyRequestedError VRF provider 已有未完成的随机数请求
+type VRFRequestAlreadyRequestedError struct {
+	revertError
+}
+
+// newDailyLotteryError 将解析后的合约错误转换为对应的类型化错误，未知错误原样返回
+func (contract *DailyLotteryContract) newDailyLotteryError(ctx context.Context, contractErr *eth.ContractError) error {
+	switch contractErr.Type {
+	case "WrongEthValue":
+		value := bigArg(contractErr, "value")
+		return &WrongEthValueError{
+			revertError: newRevertError(contractErr, fmt.Sprintf("wrong eth value %v", value)),
+			Value:       value,
+		}
+	case "NoNumbersToDraw":
+		return &NoNumbersToDrawError{revertError: newRevertError(contractErr, "no numbers to draw")}
+	case "WrongLotteryNumber":
+		param, _ := contractErr.Args["param"].(uint64)
+		current, _ := contractErr.Args["current"].(uint64)
+		return &WrongLotteryNumberError{
+			revertError: newRevertError(contractErr, fmt.Sprintf("wrong lottery number %d, current is %d", param, current)),
+			Param:       param,
+			Current:     current,
+		}
+	case "DrawingInProgress":
+		return &DrawingInProgressError{revertError: newRevertError(contractErr, "lottery is already drawing")}
+	case "MinDrawIntervalNotMet":
+		return contract.newMinDrawIntervalNotMetError(ctx, contractErr)
+	case "TransferFailed":
+		value := bigArg(contractErr, "value")
+		return &TransferFailedError{
+			revertError: newRevertError(contractErr, fmt.Sprintf("transfer %v wei failed", value)),
+			Value:       value,
+		}
+	case "OnlyRandProvider":
+		sender, _ := contractErr.Args["sender"].(common.Address)
+		return &OnlyRandProviderError{
+			revertError: newRevertError(contractErr, "only rand provider, sender "+sender.Hex()),
+			Sender:      sender,
+		}
+	case "OwnableUnauthorizedAccount":
+		account, _ := contractErr.Args["account"].(common.Address)
+		return &OwnableUnauthorizedAccountError{
+			revertError: newRevertError(contractErr, "account "+account.Hex()+" is not the contract owner"),
+			Account:     account,
+		}
+	case "VRFRequestFailed":
+		return &VRFRequestFailedError{revertError: newRevertError(contractErr, "vrf request failed")}
+	case "VRFRequestAlreadyRequested":
+		return &VRFRequestAlreadyRequestedError{revertError: newRevertError(contractErr, "vrf request already requested")}
+	}
+	return contractErr
+}
+
+// newMinDrawIntervalNotMetError 读取最小间隔计算允许开奖的时间，如 "draw allowed in 3h12m"
+func (contract *DailyLotteryContract) newMinDrawIntervalNotMetError(ctx context.Context, contractErr *eth.ContractError) error {
+	drawErr := &MinDrawIntervalNotMetError{
+		StartTime:   time.Unix(bigArg(contractErr, "startTime").Int64(), 0),
+		CurrentTime: time.Unix(bigArg(contractErr, "currentTime").Int64(), 0),
+	}
+
+	message := "min draw interval not met"
+	if interval, err := contract.MinDrawInterval(ctx); err == nil {
+		drawErr.AllowedAt = drawErr.StartTime.Add(interval)
+		message = "draw allowed in " + formatWait(drawErr.AllowedAt.Sub(drawErr.CurrentTime))
+	}
+	drawErr.revertError = newRevertError(contractErr, message)
+	return drawErr
+}
+
+// bigArg 获取uint256参数，不存在时返回0
+func bigArg(contractErr *eth.ContractError, name string) *big.Int {
+	if value, ok := contractErr.Args[name].(*big.Int); ok {
+		return value
+	}
+	return new(big.Int)
+}
+
+// formatWait 等待时间精确到分钟，如 3h12m
+func formatWait(d time.Duration) string {
+	if d < time.Minute {
+		return "less than 1m"
+	}
+	d = d.Truncate(time.Minute)
+	if d < time.Hour {
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+	return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+}
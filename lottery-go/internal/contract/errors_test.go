@@ -0,0 +1,161 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"lottery-go/internal/config"
+	"lottery-go/internal/pkg/eth"
+)
+
+// newMockContract 本地RPC节点，eth_call 按函数选择器返回 results 中的结果
+func newMockContract(t *testing.T, results map[string][]byte) *DailyLotteryContract {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		result := interface{}("0x1")
+		switch req.Method {
+		case "eth_getCode":
+			result = "0x6080"
+		case "eth_call":
+			var msg struct {
+				Data  hexutil.Bytes `json:"data"`
+				Input hexutil.Bytes `json:"input"`
+			}
+			_ = json.Unmarshal(req.Params[0], &msg)
+			if msg.Data == nil {
+				msg.Data = msg.Input
+			}
+			result = hexutil.Encode(results[hexutil.Encode(msg.Data[:4])])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := eth.NewClient(eth.Options{Endpoints: []eth.Endpoint{{Url: server.URL}}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	t.Cleanup(client.Close)
+
+	return &DailyLotteryContract{config: &config.Contract{Address: address}, client: client}
+}
+
+// uint256Result 编码为uint256的返回值
+func uint256Result(v int64) []byte {
+	return common.LeftPadBytes(big.NewInt(v).Bytes(), 32)
+}
+
+// selector 函数选择器
+func selector(data []byte) string {
+	return hexutil.Encode(data[:4])
+}
+
+// newTestErrorContract minDrawInterval 为23小时
+func newTestErrorContract(t *testing.T) *DailyLotteryContract {
+	return newMockContract(t, map[string][]byte{
+		selector(dailyLottery.PackMinDrawInterval()): uint256Result(23 * 3600),
+	})
+}
+
+// revertOf 构造合约revert错误
+func revertOf(t *testing.T, name string, args ...interface{}) *eth.ContractError {
+	t.Helper()
+	errorABI, err := abi.JSON(strings.NewReader(dailyLotteryErrorABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	abiErr, ok := errorABI.Errors[name]
+	if !ok {
+		t.Fatalf("error %s not in abi", name)
+	}
+	payload, err := abiErr.Inputs.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := hexutil.Encode(append(abiErr.ID[:4:4], payload...))
+
+	contractErr := eth.ParseContractError(dailyLotteryErrorABI, &revertDataError{data: data})
+	if contractErr == nil || contractErr.Type != name {
+		t.Fatalf("fails to parse %s, got %v", name, contractErr)
+	}
+	return contractErr
+}
+
+type revertDataError struct {
+	data string
+}
+
+func (e *revertDataError) Error() string          { return "execution reverted" }
+func (e *revertDataError) ErrorData() interface{} { return e.data }
+
+func TestNewDailyLotteryError_MinDrawIntervalNotMet(t *testing.T) {
+	contract := newTestErrorContract(t)
+	start := int64(1_700_000_000)
+	err := contract.newDailyLotteryError(context.Background(),
+		revertOf(t, "MinDrawIntervalNotMet", big.NewInt(start), big.NewInt(start+(19*3600+48*60))))
+
+	var intervalErr *MinDrawIntervalNotMetError
+	if !errors.As(err, &intervalErr) {
+		t.Fatalf("expected MinDrawIntervalNotMetError, got %T", err)
+	}
+	if err.Error() != "draw allowed in 3h12m" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+
+	// 仍可作为 *eth.ContractError 处理
+	var contractErr *eth.ContractError
+	if !errors.As(err, &contractErr) || contractErr.Type != "MinDrawIntervalNotMet" {
+		t.Fatalf("expected eth.ContractError in chain, got %v", contractErr)
+	}
+}
+
+func TestNewDailyLotteryError_Typed(t *testing.T) {
+	contract := newTestErrorContract(t)
+	account := common.HexToAddress("0x01")
+
+	tests := []struct {
+		name   string
+		err    *eth.ContractError
+		target interface{}
+	}{
+		{"OwnableUnauthorizedAccount", revertOf(t, "OwnableUnauthorizedAccount", account), new(*OwnableUnauthorizedAccountError)},
+		{"WrongLotteryNumber", revertOf(t, "WrongLotteryNumber", uint64(3), uint64(4)), new(*WrongLotteryNumberError)},
+		{"WrongEthValue", revertOf(t, "WrongEthValue", big.NewInt(1)), new(*WrongEthValueError)},
+		{"TransferFailed", revertOf(t, "TransferFailed", big.NewInt(1)), new(*TransferFailedError)},
+		{"OnlyRandProvider", revertOf(t, "OnlyRandProvider", account), new(*OnlyRandProviderError)},
+		{"NoNumbersToDraw", revertOf(t, "NoNumbersToDraw"), new(*NoNumbersToDrawError)},
+		{"DrawingInProgress", revertOf(t, "DrawingInProgress"), new(*DrawingInProgressError)},
+		{"VRFRequestFailed", revertOf(t, "VRFRequestFailed"), new(*VRFRequestFailedError)},
+		{"VRFRequestAlreadyRequested", revertOf(t, "VRFRequestAlreadyRequested"), new(*VRFRequestAlreadyRequestedError)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := contract.newDailyLotteryError(context.Background(), test.err)
+			if !errors.As(err, test.target) {
+				t.Fatalf("expected %T, got %T", test.target, err)
+			}
+		})
+	}
+
+	err := contract.newDailyLotteryError(context.Background(), revertOf(t, "OwnableUnauthorizedAccount", account))
+	var unauthorized *OwnableUnauthorizedAccountError
+	if !errors.As(err, &unauthorized) || unauthorized.Account != account {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
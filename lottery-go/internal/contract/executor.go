@@ -0,0 +1,111 @@
+package contract
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/config"
+)
+
+const (
+	// drawViaDirect 签名者直接调用 drawLottery，签名者须为合约owner
+	drawViaDirect = "direct"
+	// drawViaExecutor 签名者调用owner控制的执行合约，由执行合约转发 drawLottery，签名者只需执行合约授权
+	drawViaExecutor = "executor"
+)
+
+// drawExecutorABI 执行合约的转发接口。执行合约可以是持有lottery所有权的转发合约，也可以是Safe模块，
+// 由owner授权operator调用lottery的 drawLottery（以及刮刮卡的owner函数），并原样冒泡目标合约的revert数据
+const drawExecutorABI = `[{"type":"function","name":"execute","stateMutability":"nonpayable",
+	"inputs":[{"name":"target","type":"address"},{"name":"data","type":"bytes"}],
+	"outputs":[{"name":"","type":"bytes"}]}]`
+
+var drawExecutor = mustParseABI(drawExecutorABI)
+
+func mustParseABI(abiJSON string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// drawViaMode owner函数交易的发送方式，未配置时直接调用
+func drawViaMode(via config.DrawVia) string {
+	if via.Mode == "" {
+		return drawViaDirect
+	}
+	return via.Mode
+}
+
+// ownerCall owner函数交易的目标地址和调用数据，executor 模式下调用执行合约的 execute(target, data)
+func ownerCall(via config.DrawVia, target common.Address, data []byte) (string, []byte, error) {
+	switch mode := drawViaMode(via); mode {
+	case drawViaDirect:
+		return target.Hex(), data, nil
+	case drawViaExecutor:
+		if !common.IsHexAddress(via.Executor) {
+			return "", nil, errorx.New("invalid executor address", "executor", via.Executor)
+		}
+		forward, err := drawExecutor.Pack("execute", target, data)
+		if err != nil {
+			return "", nil, errorx.Wrap("failed to pack executor call", err)
+		}
+		return via.Executor, forward, nil
+	default:
+		return "", nil, errorx.New("unknown drawVia mode", "mode", mode)
+	}
+}
+
+// drawVia 开奖交易的发送方式，未配置时直接调用
+func (contract *DailyLotteryContract) drawVia() string {
+	return drawViaMode(contract.config.DrawVia)
+}
+
+// drawCall 开奖交易的目标地址和调用数据，executor 模式下调用执行合约的 execute(lottery, drawLottery(n))
+func (contract *DailyLotteryContract) drawCall(lotteryNumber uint64) (string, []byte, error) {
+	return ownerCall(contract.config.DrawVia, contract.address(), dailyLottery.PackDrawLottery(lotteryNumber))
+}
+
+// checkExecutor executor 模式的就绪检查：执行合约存在代码，lottery 的owner是执行合约（或其背后的Safe），
+// 且签名者不是owner。签名者是owner时泄露后可升级代理合约、修改所有地址，视为未就绪
+func (contract *DailyLotteryContract) checkExecutor(ctx context.Context) error {
+	executor := contract.config.DrawVia.Executor
+	if !common.IsHexAddress(executor) {
+		return errorx.New("invalid executor address", "executor", executor)
+	}
+	safe := contract.config.DrawVia.Safe
+	if safe != "" && !common.IsHexAddress(safe) {
+		return errorx.New("invalid safe address", "safe", safe)
+	}
+
+	code, err := contract.client.CodeAt(ctx, common.HexToAddress(executor))
+	if err != nil {
+		return err
+	}
+	if len(code) == 0 {
+		return errorx.New("no contract code at executor address", "executor", executor)
+	}
+
+	operator, err := contract.Operator()
+	if err != nil {
+		return err
+	}
+	owner, err := contract.Owner(ctx)
+	if err != nil {
+		return err
+	}
+	if owner == operator {
+		return errorx.New("signer is the contract owner, transfer ownership to the executor or the safe behind it "+
+			"and use a low-privilege operator key", "signer", operator.Hex(), "executor", executor)
+	}
+	// 执行合约转发的 drawLottery 是 onlyOwner，owner 须为执行合约或启用该模块的Safe
+	if owner != common.HexToAddress(executor) && (safe == "" || owner != common.HexToAddress(safe)) {
+		return errorx.New("executor is not allowed to draw, lottery owner is neither the executor nor the safe",
+			"owner", owner.Hex(), "executor", executor, "safe", safe)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package contract
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"lottery-go/internal/config"
+	"lottery-go/internal/pkg/eth"
+)
+
+const executor = "0x00000000000000000000000000000000000000e0"
+
+func TestDrawCall(t *testing.T) {
+	contract := &DailyLotteryContract{config: &config.Contract{Address: address}}
+
+	to, data, err := contract.drawCall(7)
+	if err != nil || to != address || !bytes.Equal(data, dailyLottery.PackDrawLottery(7)) {
+		t.Fatalf("unexpected direct call, to=%s err=%v", to, err)
+	}
+
+	contract.config.DrawVia = config.DrawVia{Mode: drawViaExecutor, Executor: executor}
+	to, data, err = contract.drawCall(7)
+	if err != nil || to != executor {
+		t.Fatalf("unexpected executor call, to=%s err=%v", to, err)
+	}
+	args, err := drawExecutor.Methods["execute"].Inputs.Unpack(data[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args[0].(common.Address) != common.HexToAddress(address) || !bytes.Equal(args[1].([]byte), dailyLottery.PackDrawLottery(7)) {
+		t.Fatalf("executor call does not forward drawLottery, %v", args)
+	}
+
+	contract.config.DrawVia = config.DrawVia{Mode: "relay"}
+	if _, _, err = contract.drawCall(7); err == nil {
+		t.Fatal("expected error for unknown mode")
+	}
+}
+
+func TestCheckExecutor(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signer := eth.NewKeySigner(key)
+	safe := common.HexToAddress("0x00000000000000000000000000000000000000f0")
+
+	tests := []struct {
+		name  string
+		owner common.Address
+		safe  string
+		ok    bool
+	}{
+		{name: "executor owns lottery", owner: common.HexToAddress(executor), ok: true},
+		{name: "safe owns lottery", owner: safe, safe: safe.Hex(), ok: true},
+		{name: "signer owns lottery", owner: signer.Address()},
+		{name: "executor not allowed", owner: common.HexToAddress("0x0b")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contract := newMockContract(t, map[string][]byte{
+				selector(dailyLottery.PackOwner()): common.LeftPadBytes(tt.owner.Bytes(), 32),
+			})
+			contract.signer = signer
+			contract.config.DrawVia = config.DrawVia{Mode: drawViaExecutor, Executor: executor, Safe: tt.safe}
+
+			checks := contract.ReadinessChecks()
+			if last := checks[len(checks)-1]; last.Name != "executor" {
+				t.Fatalf("expected executor check, got %s", last.Name)
+			}
+			if err := contract.checkExecutor(context.Background()); (err == nil) != tt.ok {
+				t.Fatalf("expected ok %t, got %v", tt.ok, err)
+			}
+		})
+	}
+
+	contract := &DailyLotteryContract{config: &config.Contract{DrawVia: config.DrawVia{Mode: drawViaExecutor}}}
+	if err := contract.checkExecutor(context.Background()); err == nil {
+		t.Fatal("expected error for missing executor address")
+	}
+}
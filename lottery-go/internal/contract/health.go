@@ -0,0 +1,81 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/pkg/health"
+)
+
+// Owner 合约的owner地址
+func (contract *DailyLotteryContract) Owner(ctx context.Context) (common.Address, error) {
+	return callView(ctx, contract.client, contract.address(), "owner",
+		dailyLottery.PackOwner(), dailyLottery.UnpackOwner)
+}
+
+// ReadinessChecks 就绪检查：RPC可用、链ID与配置一致、合约地址存在代码，
+// direct 模式下发送交易的账户是合约owner，executor 模式下owner是执行合约（或Safe）且不是发送交易的账户。
+// 同时用于启动自检，任一项不通过时拒绝启动。
+func (contract *DailyLotteryContract) ReadinessChecks() []health.Check {
+	checks := []health.Check{
+		{Name: "rpc", Fn: contract.checkRpc},
+		{Name: "chainId", Fn: contract.checkChainID},
+		{Name: "contractCode", Fn: contract.checkCode},
+	}
+	if contract.drawVia() == drawViaExecutor {
+		return append(checks, health.Check{Name: "executor", Fn: contract.checkExecutor})
+	}
+	return append(checks, health.Check{Name: "owner", Fn: contract.checkOwner})
+}
+
+func (contract *DailyLotteryContract) checkRpc(ctx context.Context) error {
+	_, err := contract.client.BlockNumber(ctx)
+	return err
+}
+
+func (contract *DailyLotteryContract) checkChainID(ctx context.Context) error {
+	chainID, err := contract.client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	if expected := contract.config.ChainId; expected != 0 && (!chainID.IsUint64() || chainID.Uint64() != expected) {
+		return errorx.New("chain id mismatch", "expected", expected, "actual", chainID)
+	}
+	return nil
+}
+
+func (contract *DailyLotteryContract) checkCode(ctx context.Context) error {
+	if !common.IsHexAddress(contract.config.Address) {
+		return errorx.New("invalid contract address", "address", contract.config.Address)
+	}
+
+	code, err := contract.client.CodeAt(ctx, common.HexToAddress(contract.config.Address))
+	if err != nil {
+		return err
+	}
+	if len(code) == 0 {
+		return errorx.New("no contract code at address", "address", contract.config.Address)
+	}
+	return nil
+}
+
+func (contract *DailyLotteryContract) checkOwner(ctx context.Context) error {
+	if mode := contract.drawVia(); mode != drawViaDirect {
+		return errorx.New("unknown drawVia mode", "mode", mode)
+	}
+
+	operator, err := contract.Operator()
+	if err != nil {
+		return err
+	}
+
+	owner, err := contract.Owner(ctx)
+	if err != nil {
+		return err
+	}
+	if owner != operator {
+		return errorx.New("signer is not the contract owner", "signer", operator.Hex(), "owner", owner.Hex())
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package contract
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/base/errorx"
+)
+
+// LotteryData 一期彩票的数据，对应合约的 LotteryData
+type LotteryData struct {
+	LotteryNumber  uint64
+	PricePerNumber *big.Int // 每个号码的价格（wei）
+	FeeRate        uint8    // 手续费比例（%）
+	TotalAmount    *big.Int // 奖池总额（wei）
+	Fee            *big.Int // 开奖后的手续费（wei）
+	Prize          *big.Int // 开奖后中奖者获得的奖金（wei）
+	DrawState      DrawState
+	DrawTime       time.Time // 开奖前为本期开始时间，开奖后为开奖时间
+}
+
+// WinnerData 一期彩票的中奖数据，对应合约的 WinnerData
+type WinnerData struct {
+	Winner        common.Address
+	TokenId       *big.Int // 中奖NFT的tokenId
+	Number        uint64   // 中奖号码
+	LotteryNumber uint64
+}
+
+// LotteryHistory 一期彩票的数据和中奖数据，未开奖或无人参与时 Winner 为nil
+type LotteryHistory struct {
+	Lottery *LotteryData
+	Winner  *WinnerData
+}
+
+// Lottery 获取一期彩票的数据
+func (contract *DailyLotteryContract) Lottery(ctx context.Context, lotteryNumber uint64) (*LotteryData, error) {
+	output, err := callView(ctx, contract.client, contract.address(), "lotterys",
+		dailyLottery.PackLotterys(lotteryNumber), dailyLottery.UnpackLotterys)
+	if err != nil {
+		return nil, err
+	}
+	return &LotteryData{
+		LotteryNumber:  output.LotteryNumber,
+		PricePerNumber: output.PricePerNumber,
+		FeeRate:        output.FeeRate,
+		TotalAmount:    output.TotalAmount,
+		Fee:            output.Fee,
+		Prize:          output.Prize,
+		DrawState:      drawStates[output.DrawState],
+		DrawTime:       time.Unix(output.DrawTime.Int64(), 0),
+	}, nil
+}
+
+// Winner 获取一期彩票的中奖数据，未开奖或无人参与时 Winner 为零地址
+func (contract *DailyLotteryContract) Winner(ctx context.Context, lotteryNumber uint64) (*WinnerData, error) {
+	output, err := callView(ctx, contract.client, contract.address(), "getWinnerData",
+		dailyLottery.PackGetWinnerData(lotteryNumber), dailyLottery.UnpackGetWinnerData)
+	if err != nil {
+		return nil, err
+	}
+	return &WinnerData{
+		Winner:        output.Winner,
+		TokenId:       output.TokenId,
+		Number:        output.Number,
+		LotteryNumber: output.LotteryNumber,
+	}, nil
+}
+
+// TotalAmount 奖池总额（wei）
+func (contract *DailyLotteryContract) TotalAmount(ctx context.Context, lotteryNumber uint64) (*big.Int, error) {
+	return callView(ctx, contract.client, contract.address(), "getTotalAmount",
+		dailyLottery.PackGetTotalAmount(lotteryNumber), dailyLottery.UnpackGetTotalAmount)
+}
+
+// PricePerNumber 每个号码的价格（wei）
+func (contract *DailyLotteryContract) PricePerNumber(ctx context.Context, lotteryNumber uint64) (*big.Int, error) {
+	return callView(ctx, contract.client, contract.address(), "getPricePerNumber",
+		dailyLottery.PackGetPricePerNumber(lotteryNumber), dailyLottery.UnpackGetPricePerNumber)
+}
+
+// FeeRate 手续费比例（%）
+func (contract *DailyLotteryContract) FeeRate(ctx context.Context, lotteryNumber uint64) (uint8, error) {
+	return callView(ctx, contract.client, contract.address(), "getFeeRate",
+		dailyLottery.PackGetFeeRate(lotteryNumber), dailyLottery.UnpackGetFeeRate)
+}
+
+// DrawTime 开奖前为本期开始时间，开奖后为开奖时间
+func (contract *DailyLotteryContract) DrawTime(ctx context.Context, lotteryNumber uint64) (time.Time, error) {
+	drawTime, err := callView(ctx, contract.client, contract.address(), "getDrawTime",
+		dailyLottery.PackGetDrawTime(lotteryNumber), dailyLottery.UnpackGetDrawTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(drawTime.Int64(), 0), nil
+}
+
+// AddressByNumber 持有某期某个号码的地址，号码未被领取时为零地址
+func (contract *DailyLotteryContract) AddressByNumber(ctx context.Context, lotteryNumber, number uint64) (common.Address, error) {
+	return callView(ctx, contract.client, contract.address(), "getAddressByNumber",
+		dailyLottery.PackGetAddressByNumber(lotteryNumber, number), dailyLottery.UnpackGetAddressByNumber)
+}
+
+// History 按期号顺序读取 [from, to] 的彩票数据和中奖数据，to 超过当前期号时截止到当前期号
+func (contract *DailyLotteryContract) History(ctx context.Context, from, to uint64) ([]*LotteryHistory, error) {
+	if from == 0 || from > to {
+		return nil, errorx.New("invalid lottery number range", "from", from, "to", to)
+	}
+
+	current, err := contract.LotteryNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	to = min(to, current)
+
+	history := make([]*LotteryHistory, 0, max(to+1, from)-from)
+	for lotteryNumber := from; lotteryNumber <= to; lotteryNumber++ {
+		lottery, err := contract.Lottery(ctx, lotteryNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		record := &LotteryHistory{Lottery: lottery}
+		if lottery.DrawState == Drawn {
+			winner, err := contract.Winner(ctx, lotteryNumber)
+			if err != nil {
+				return nil, err
+			}
+			if winner.Winner != (common.Address{}) {
+				record.Winner = winner
+			}
+		}
+		history = append(history, record)
+	}
+	return history, nil
+}
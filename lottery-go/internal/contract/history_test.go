@@ -0,0 +1,68 @@
+package contract
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/contract/abis"
+)
+
+// packOutputs 按ABI编码函数的返回值
+func packOutputs(t *testing.T, method string, values ...interface{}) []byte {
+	t.Helper()
+	parsed, err := abis.Load(abis.DailyLotteryV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := parsed.Methods[method].Outputs.Pack(values...)
+	if err != nil {
+		t.Fatalf("fails to pack %s outputs, %v", method, err)
+	}
+	return data
+}
+
+func TestDailyLotteryContract_History(t *testing.T) {
+	winner := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	drawTime := time.Unix(1760000000, 0)
+	contract := newMockContract(t, map[string][]byte{
+		selector(dailyLottery.PackLotteryNumber()): uint256Result(3),
+		selector(dailyLottery.PackLotterys(1)): packOutputs(t, "lotterys", uint64(1), big.NewInt(1e15), uint8(5),
+			big.NewInt(4e15), big.NewInt(2e14), big.NewInt(38e14), uint8(Drawn), big.NewInt(drawTime.Unix())),
+		selector(dailyLottery.PackGetWinnerData(1)): packOutputs(t, "getWinnerData", struct {
+			Winner        common.Address
+			TokenId       *big.Int
+			Number        uint64
+			LotteryNumber uint64
+		}{winner, big.NewInt(9), 2, 1}),
+		selector(dailyLottery.PackGetAddressByNumber(1, 2)): common.LeftPadBytes(winner.Bytes(), 32),
+	})
+	ctx := context.Background()
+
+	// 期号超过当前期号时截止到当前期号
+	history, err := contract.History(ctx, 2, 10)
+	if err != nil {
+		t.Fatalf("fails to History(), %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("expected 2 lotteries, got %d", len(history))
+	}
+
+	record := history[0]
+	if record.Lottery.DrawState != Drawn || record.Lottery.Prize.Cmp(big.NewInt(38e14)) != 0 ||
+		!record.Lottery.DrawTime.Equal(drawTime) {
+		t.Fatalf("unexpected lottery data %+v", record.Lottery)
+	}
+	if record.Winner == nil || record.Winner.Winner != winner || record.Winner.TokenId.Int64() != 9 {
+		t.Fatalf("unexpected winner data %+v", record.Winner)
+	}
+
+	if holder, err := contract.AddressByNumber(ctx, 1, 2); err != nil || holder != winner {
+		t.Fatalf("AddressByNumber() = %s, %v", holder.Hex(), err)
+	}
+	if _, err = contract.History(ctx, 3, 2); err == nil {
+		t.Fatal("expected error for invalid range")
+	}
+}
@@ -2,4 +2,4 @@ package contract
 
 import "github.com/google/wire"
 
-var ProviderSet = wire.NewSet(NewDailyLotteryContract)
+var ProviderSet = wire.NewSet(NewEthClient, NewSigner, NewDailyLotteryContract, NewScratchCardContract)
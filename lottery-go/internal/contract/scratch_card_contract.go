@@ -0,0 +1,262 @@
+package contract
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/config"
+	"lottery-go/internal/pkg/eth"
+)
+
+// ScratchCardContract 刮刮卡合约，与 DailyLotteryContract 共用RPC客户端和签名者
+type ScratchCardContract struct {
+	config        *config.ScratchCardContract
+	client        *eth.Client
+	signer        eth.Signer
+	gasPricer     eth.GasPricer
+	confirmations uint64         // 与 daily-lottery 在同一条链上，使用相同的确认数
+	drawVia       config.DrawVia // owner函数与 drawLottery 使用相同的发送方式，executor 模式下签名者不是owner
+}
+
+// Prize 刮刮卡奖项，对应合约的 ScratchCardPrize
+type Prize uint8
+
+const (
+	NoPrize Prize = iota
+	GrandPrize
+	SmallPrize
+	LuckyPrize
+)
+
+func (prize Prize) String() string {
+	switch prize {
+	case NoPrize:
+		return "NoPrize"
+	case GrandPrize:
+		return "GrandPrize"
+	case SmallPrize:
+		return "SmallPrize"
+	case LuckyPrize:
+		return "LuckyPrize"
+	}
+	return "Unknown"
+}
+
+// ScratchCardEvent 刮刮卡的购买（ScratchCardEvent）或开奖（LotteryResultEvent）事件，Result 为nil时是购买
+type ScratchCardEvent struct {
+	User        common.Address
+	Time        time.Time // 事件所在区块的时间
+	BlockNumber uint64
+	TxHash      common.Hash
+	Value       *big.Int           // 购买支付的金额（wei）
+	Result      *ScratchCardResult // 开奖结果，购买事件为nil
+}
+
+// ScratchCardResult 刮刮卡的开奖结果
+type ScratchCardResult struct {
+	Prize        Prize
+	Amount       *big.Int // 中奖者获得的奖金（wei），未中奖为0
+	RandomNumber *big.Int
+}
+
+func NewScratchCardContract(client *eth.Client, signer eth.Signer) *ScratchCardContract {
+	conf := config.ScratchCard()
+	if conf == nil {
+		conf = &config.ScratchCardContract{}
+	}
+	contract := &ScratchCardContract{config: conf, client: client, signer: signer, gasPricer: newGasPricer(conf.Gas)}
+	if lottery := config.DailyLottery(); lottery != nil {
+		contract.confirmations, contract.drawVia = lottery.Confirmations, lottery.DrawVia
+	}
+	return contract
+}
+
+// Enabled 是否配置了刮刮卡合约地址
+func (contract *ScratchCardContract) Enabled() bool {
+	return common.IsHexAddress(contract.config.Address)
+}
+
+// ResultTimeout 购买后等待开奖结果的最长时间
+func (contract *ScratchCardContract) ResultTimeout() time.Duration {
+	return contract.config.ResultTimeout
+}
+
+// LookbackBlocks 启动时向前扫描事件的区块数
+func (contract *ScratchCardContract) LookbackBlocks() uint64 {
+	return contract.config.LookbackBlocks
+}
+
+func (contract *ScratchCardContract) address() common.Address {
+	return common.HexToAddress(contract.config.Address)
+}
+
+// Owner 合约的owner地址
+func (contract *ScratchCardContract) Owner(ctx context.Context) (common.Address, error) {
+	return callView(ctx, contract.client, contract.address(), "owner",
+		scratchCard.PackOwner(), scratchCard.UnpackOwner)
+}
+
+// configAddress 刮刮卡配置合约的地址
+func (contract *ScratchCardContract) configAddress(ctx context.Context) (common.Address, error) {
+	return callView(ctx, contract.client, contract.address(), "configContract",
+		scratchCard.PackConfigContract(), scratchCard.UnpackConfigContract)
+}
+
+// Price 每张刮刮卡的价格（wei），读取自配置合约
+func (contract *ScratchCardContract) Price(ctx context.Context) (*big.Int, error) {
+	configAddr, err := contract.configAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return callView(ctx, contract.client, configAddr, "Price",
+		scratchCardConfig.PackPrice(), scratchCardConfig.UnpackPrice)
+}
+
+// FeeRate 奖金的手续费比例（%），读取自配置合约
+func (contract *ScratchCardContract) FeeRate(ctx context.Context) (uint8, error) {
+	configAddr, err := contract.configAddress(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return callView(ctx, contract.client, configAddr, "FeeRate",
+		scratchCardConfig.PackFeeRate(), scratchCardConfig.UnpackFeeRate)
+}
+
+// PoolBalance 奖池余额（wei），即合约的ETH余额，奖金按余额的比例发放
+func (contract *ScratchCardContract) PoolBalance(ctx context.Context) (*big.Int, error) {
+	return contract.client.BalanceAt(ctx, contract.address())
+}
+
+// Fund owner向奖池注入ETH，executor 模式下不能通过执行合约发送
+func (contract *ScratchCardContract) Fund(ctx context.Context, value *big.Int) (*eth.TxResult, error) {
+	if value == nil || value.Sign() <= 0 {
+		return nil, errorx.New("fund value must be positive", "value", value)
+	}
+	return contract.transact(ctx, "fund", scratchCard.PackFund(), value)
+}
+
+// SetResultAddress 修改开奖结果合约地址
+func (contract *ScratchCardContract) SetResultAddress(ctx context.Context, address common.Address) (*eth.TxResult, error) {
+	return contract.transact(ctx, "setResultAddress", scratchCard.PackSetResultAddress(address), nil)
+}
+
+// SetTokenAddress 修改奖品NFT合约地址
+func (contract *ScratchCardContract) SetTokenAddress(ctx context.Context, address common.Address) (*eth.TxResult, error) {
+	return contract.transact(ctx, "setTokenAddress", scratchCard.PackSetTokenAddress(address), nil)
+}
+
+// SetRandProviderAddress 修改随机数提供合约地址
+func (contract *ScratchCardContract) SetRandProviderAddress(ctx context.Context, address common.Address) (*eth.TxResult, error) {
+	return contract.transact(ctx, "setRandProviderAddress", scratchCard.PackSetRandProviderAddress(address), nil)
+}
+
+// SetConfigAddress 修改配置合约地址
+func (contract *ScratchCardContract) SetConfigAddress(ctx context.Context, address common.Address) (*eth.TxResult, error) {
+	return contract.transact(ctx, "setConfigAddress", scratchCard.PackSetConfigAddress(address), nil)
+}
+
+// ownerCall owner交易的目标地址和调用数据，executor 模式下由执行合约转发。
+// 执行合约的 execute 不接收ETH，executor 模式下不能发送带金额的owner交易（如 fund），须由owner直接发送
+func (contract *ScratchCardContract) ownerCall(data []byte, value *big.Int) (string, []byte, error) {
+	if drawViaMode(contract.drawVia) == drawViaExecutor && value != nil && value.Sign() > 0 {
+		return "", nil, errorx.New("executor cannot forward value, send the transaction from the owner", "value", value)
+	}
+	return ownerCall(contract.drawVia, contract.address(), data)
+}
+
+// transact 发送owner交易，返回交易达到确认数后的结果，合约revert时（revert数据由执行合约冒泡）返回解析后的 *eth.ContractError
+func (contract *ScratchCardContract) transact(ctx context.Context, funcName string, data []byte, value *big.Int) (*eth.TxResult, error) {
+	to, data, err := contract.ownerCall(data, value)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := contract.client.SendTransaction(ctx, &eth.TransactionContext{
+		Address:        to,
+		FuncName:       funcName,
+		Data:           data,
+		Value:          value,
+		Signer:         contract.signer,
+		GasPricer:      contract.gasPricer,
+		GasLimitMargin: contract.config.Gas.GasLimitMargin,
+		Replace:        replacePolicy(contract.config.Gas),
+		Simulate:       contract.config.Simulate,
+		ErrorAbi:       scratchCardErrorABI,
+	})
+	if err != nil {
+		if contractErr := eth.ParseContractError(scratchCardErrorABI, err); contractErr != nil {
+			return nil, contractErr
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// ConfirmedBlockNumber 达到确认数的最新区块，之后的区块仍可能被回滚，扫描事件不应超过该区块
+func (contract *ScratchCardContract) ConfirmedBlockNumber(ctx context.Context) (uint64, error) {
+	latest, err := contract.client.BlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// 确认数含交易所在区块
+	depth := max(contract.confirmations, 1) - 1
+	return latest - min(depth, latest), nil
+}
+
+// Events 按链上顺序返回 [from, to] 区块内的购买和开奖事件，区块范围受 maxBlockRange 限制时分段查询
+func (contract *ScratchCardContract) Events(ctx context.Context, from, to uint64) ([]*ScratchCardEvent, error) {
+	step := max(contract.config.MaxBlockRange, 1)
+
+	var events []*ScratchCardEvent
+	for start := from; start <= to; start += step {
+		end := min(start+step-1, to)
+		logs, err := contract.client.FilterLogs(ctx, "scratchCardEvents", ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []common.Address{contract.address()},
+			Topics:    [][]common.Hash{{scratchCardEventTopic, lotteryResultEventTopic}},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range logs {
+			// 区块回滚后被移除的日志
+			if logs[i].Removed {
+				continue
+			}
+			event, err := decodeScratchCardEvent(&logs[i])
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// decodeScratchCardEvent 解码购买或开奖事件的日志
+func decodeScratchCardEvent(log *types.Log) (*ScratchCardEvent, error) {
+	event := &ScratchCardEvent{BlockNumber: log.BlockNumber, TxHash: log.TxHash}
+	if log.Topics[0] == scratchCardEventTopic {
+		request, err := scratchCard.UnpackScratchCardEventEvent(log)
+		if err != nil {
+			return nil, errorx.Wrap("failed to unpack ScratchCardEvent", err, "txHash", log.TxHash.Hex())
+		}
+		event.User, event.Time, event.Value = request.User, time.Unix(request.Timestamp.Int64(), 0), request.Value
+		return event, nil
+	}
+
+	result, err := scratchCard.UnpackLotteryResultEventEvent(log)
+	if err != nil {
+		return nil, errorx.Wrap("failed to unpack LotteryResultEvent", err, "txHash", log.TxHash.Hex())
+	}
+	event.User, event.Time = result.User, time.Unix(result.Timestamp.Int64(), 0)
+	event.Result = &ScratchCardResult{Prize: Prize(result.Prize), Amount: result.Amount, RandomNumber: result.RandomNumber}
+	return event, nil
+}
@@ -0,0 +1,151 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"lottery-go/internal/config"
+	"lottery-go/internal/contract/abis"
+	"lottery-go/internal/pkg/eth"
+)
+
+// newScratchCardLog 按ABI编码刮刮卡事件的日志，indexed 为indexed参数，data 为非indexed参数
+func newScratchCardLog(t *testing.T, event string, block uint64, indexed []interface{}, data ...interface{}) *types.Log {
+	t.Helper()
+	parsed, err := abis.Load(abis.ScratchCardV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := parsed.Events[event].Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	topics := []common.Hash{parsed.Events[event].ID}
+	for _, value := range indexed {
+		hashes, err := abi.MakeTopics([]interface{}{value})
+		if err != nil {
+			t.Fatal(err)
+		}
+		topics = append(topics, hashes[0][0])
+	}
+	return &types.Log{Address: common.HexToAddress(address), Topics: topics, Data: payload, BlockNumber: block,
+		TxHash: common.BigToHash(new(big.Int).SetUint64(block))}
+}
+
+func TestScratchCardContract_Events(t *testing.T) {
+	user := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	logs := []*types.Log{
+		newScratchCardLog(t, "ScratchCardEvent", 100, []interface{}{user, big.NewInt(1760000000)}, big.NewInt(1e15)),
+		newScratchCardLog(t, "LotteryResultEvent", 101, []interface{}{user, big.NewInt(1760000060), uint8(SmallPrize)},
+			big.NewInt(0), big.NewInt(6)),
+	}
+	removed := *logs[0]
+	removed.Removed = true
+	logs = append(logs, &removed)
+
+	// 按请求的区块范围返回日志
+	var ranges [][2]uint64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		result := []*types.Log{}
+		if req.Method == "eth_getLogs" {
+			var query struct {
+				FromBlock hexutil.Uint64 `json:"fromBlock"`
+				ToBlock   hexutil.Uint64 `json:"toBlock"`
+			}
+			_ = json.Unmarshal(req.Params[0], &query)
+			ranges = append(ranges, [2]uint64{uint64(query.FromBlock), uint64(query.ToBlock)})
+			for _, log := range logs {
+				if log.BlockNumber >= uint64(query.FromBlock) && log.BlockNumber <= uint64(query.ToBlock) {
+					result = append(result, log)
+				}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := eth.NewClient(eth.Options{Endpoints: []eth.Endpoint{{Url: server.URL}}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	t.Cleanup(client.Close)
+
+	contract := &ScratchCardContract{config: &config.ScratchCardContract{Address: address, MaxBlockRange: 50}, client: client}
+	events, err := contract.Events(t.Context(), 1, 120)
+	if err != nil {
+		t.Fatalf("fails to Events(), %v", err)
+	}
+	if len(ranges) != 3 || ranges[2] != [2]uint64{101, 120} {
+		t.Fatalf("unexpected block ranges %v", ranges)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+
+	purchase, result := events[0], events[1]
+	if purchase.Result != nil || purchase.User != user || purchase.Value.Cmp(big.NewInt(1e15)) != 0 ||
+		!purchase.Time.Equal(time.Unix(1760000000, 0)) {
+		t.Fatalf("unexpected purchase %+v", purchase)
+	}
+	if result.Result == nil || result.Result.Prize != SmallPrize || result.Result.RandomNumber.Int64() != 6 {
+		t.Fatalf("unexpected result %+v", result.Result)
+	}
+}
+
+func TestScratchCardContract_ConfirmedBlockNumber(t *testing.T) {
+	// 模拟节点的最新区块为1
+	client := newMockContract(t, nil).client
+	for confirmations, expected := range map[uint64]uint64{0: 1, 1: 1, 2: 0, 5: 0} {
+		contract := &ScratchCardContract{config: &config.ScratchCardContract{}, client: client, confirmations: confirmations}
+		if block, err := contract.ConfirmedBlockNumber(t.Context()); err != nil || block != expected {
+			t.Fatalf("confirmations %d: expected block %d, got %d, %v", confirmations, expected, block, err)
+		}
+	}
+}
+
+func TestScratchCardContract_OwnerCall(t *testing.T) {
+	contract := &ScratchCardContract{config: &config.ScratchCardContract{Address: address}}
+	data := scratchCard.PackSetConfigAddress(common.HexToAddress("0x0c"))
+
+	to, call, err := contract.ownerCall(data, nil)
+	if err != nil || common.HexToAddress(to) != common.HexToAddress(address) || !bytes.Equal(call, data) {
+		t.Fatalf("unexpected direct call, to=%s err=%v", to, err)
+	}
+
+	// executor 模式下签名者不是owner，由执行合约转发
+	contract.drawVia = config.DrawVia{Mode: drawViaExecutor, Executor: executor}
+	to, call, err = contract.ownerCall(data, nil)
+	if err != nil || to != executor {
+		t.Fatalf("unexpected executor call, to=%s err=%v", to, err)
+	}
+	args, err := drawExecutor.Methods["execute"].Inputs.Unpack(call[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args[0].(common.Address) != common.HexToAddress(address) || !bytes.Equal(args[1].([]byte), data) {
+		t.Fatalf("executor call does not forward setConfigAddress, %v", args)
+	}
+
+	// execute 不接收ETH
+	if _, _, err = contract.ownerCall(scratchCard.PackFund(), big.NewInt(1)); err == nil {
+		t.Fatal("expected error for fund via executor")
+	}
+}
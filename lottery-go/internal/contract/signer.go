@@ -0,0 +1,81 @@
+package contract
+
+import (
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/base/logx"
+	"lottery-go/internal/config"
+	"lottery-go/internal/pkg/eth"
+)
+
+const (
+	signerKeystore = "keystore"
+	signerKeyFile  = "keyFile"
+	signerRemote   = "remote"
+)
+
+// NewSigner 按配置创建发送开奖交易的签名者，未配置 signer 时兼容明文 privateKey
+func NewSigner() (eth.Signer, func(), error) {
+	conf := config.DailyLottery()
+	signer, err := newSigner(conf.Signer, conf.PrivateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if signer == nil {
+		// 未配置时仍可启动，就绪检查和开奖时报告错误
+		logx.Warn("signer is not configured, transactions can not be sent.")
+		return nil, func() {}, nil
+	}
+
+	cleanup := func() {}
+	if remote, ok := signer.(*eth.RemoteSigner); ok {
+		cleanup = remote.Close
+	}
+	logx.Info("signer loaded.", "type", conf.Signer.Type, "address", signer.Address().Hex())
+	return signer, cleanup, nil
+}
+
+func newSigner(conf config.Signer, privateKey string) (eth.Signer, error) {
+	switch conf.Type {
+	case signerKeystore:
+		password, err := keystorePassword(conf)
+		if err != nil {
+			return nil, err
+		}
+		return eth.NewKeystoreSigner(conf.Keystore, password)
+	case signerKeyFile:
+		return eth.NewKeyFileSigner(conf.KeyFile)
+	case signerRemote:
+		if !common.IsHexAddress(conf.Address) {
+			return nil, errorx.New("invalid remote signer address", "address", conf.Address)
+		}
+		return eth.NewRemoteSigner(conf.Url, common.HexToAddress(conf.Address))
+	case "":
+		if privateKey == "" {
+			return nil, nil
+		}
+		logx.Warn("plaintext privateKey is deprecated, use a keystore, key file or remote signer instead.")
+		return eth.NewHexKeySigner(privateKey)
+	}
+	return nil, errorx.New("unknown signer type", "type", conf.Type)
+}
+
+// keystorePassword 从环境变量或密码文件读取 keystore 密码
+func keystorePassword(conf config.Signer) (string, error) {
+	if conf.PasswordEnv != "" {
+		if password, ok := os.LookupEnv(conf.PasswordEnv); ok {
+			return password, nil
+		}
+	}
+	if conf.PasswordFile != "" {
+		data, err := os.ReadFile(conf.PasswordFile)
+		if err != nil {
+			return "", errorx.Wrap("failed to read keystore password file", err, "path", conf.PasswordFile)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+	return "", errorx.New("keystore password is not configured", "passwordEnv", conf.PasswordEnv)
+}
@@ -0,0 +1,157 @@
+package contract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/contract/bindings"
+	"lottery-go/internal/pkg/eth"
+)
+
+// vrfCoordinatorABI Chainlink VRFCoordinatorV2_5 中用于诊断请求状态的view函数
+const vrfCoordinatorABI = `[
+	{"type":"function","name":"getSubscription","stateMutability":"view",
+		"inputs":[{"name":"subId","type":"uint256"}],
+		"outputs":[{"name":"balance","type":"uint96"},{"name":"nativeBalance","type":"uint96"},{"name":"reqCount","type":"uint64"},
+			{"name":"subOwner","type":"address"},{"name":"consumers","type":"address[]"}]},
+	{"type":"function","name":"s_requestCommitments","stateMutability":"view",
+		"inputs":[{"name":"requestId","type":"uint256"}],"outputs":[{"name":"","type":"bytes32"}]}
+]`
+
+var (
+	// dailyLotteryVRFProvider DailyLotteryVRFProvider 的类型安全绑定
+	dailyLotteryVRFProvider = bindings.NewDailyLotteryVRFProvider()
+
+	vrfCoordinator = mustParseABI(vrfCoordinatorABI)
+)
+
+// VRFStatus 开奖中（等待VRF回调）时 provider 和 coordinator 的链上状态
+type VRFStatus struct {
+	Provider      common.Address
+	Coordinator   common.Address
+	RequestId     *big.Int // provider 未完成的VRF请求，为0时没有未完成的请求
+	SubId         *big.Int
+	Commitment    common.Hash // coordinator 保存的请求承诺，履约（含回调失败）后删除
+	Balance       *big.Int    // 订阅的LINK余额
+	NativeBalance *big.Int    // 订阅的原生代币余额
+	IsConsumer    bool        // provider 是否是订阅的consumer
+}
+
+// RequestPending coordinator 仍在等待履约该请求
+func (status *VRFStatus) RequestPending() bool {
+	return status.Commitment != (common.Hash{})
+}
+
+// CanReRequest 合约允许重新请求随机数：provider 没有未完成的请求时，requestRandomNumbers 不会revert
+func (status *VRFStatus) CanReRequest() bool {
+	return status.RequestId.Sign() == 0
+}
+
+// Diagnosis 根据链上状态推断回调未到达的原因
+func (status *VRFStatus) Diagnosis() string {
+	switch {
+	case status.CanReRequest():
+		return "provider has no outstanding vrf request, randomness can be re-requested"
+	case !status.RequestPending():
+		return fmt.Sprintf("vrf request %v was fulfilled but the callback reverted, check callbackGasLimit; "+
+			"the provider does not permit re-requesting", status.RequestId)
+	case !status.IsConsumer:
+		return fmt.Sprintf("provider is not a consumer of subscription %v", status.SubId)
+	case status.Balance.Sign() == 0 && status.NativeBalance.Sign() == 0:
+		return fmt.Sprintf("subscription %v has no balance, fund it to get vrf request %v fulfilled", status.SubId, status.RequestId)
+	}
+	return fmt.Sprintf("vrf request %v is still pending at the coordinator", status.RequestId)
+}
+
+// RandProvider 随机数提供合约地址
+func (contract *DailyLotteryContract) RandProvider(ctx context.Context) (common.Address, error) {
+	return callView(ctx, contract.client, contract.address(), "randProviderContract",
+		dailyLottery.PackRandProviderContract(), dailyLottery.UnpackRandProviderContract)
+}
+
+// VRFStatus 读取 provider 的未完成请求，以及 coordinator 中该请求和订阅的状态
+func (contract *DailyLotteryContract) VRFStatus(ctx context.Context) (*VRFStatus, error) {
+	provider, err := contract.RandProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := &VRFStatus{Provider: provider}
+
+	if status.Coordinator, err = callView(ctx, contract.client, provider, "s_vrfCoordinator",
+		dailyLotteryVRFProvider.PackSVrfCoordinator(), dailyLotteryVRFProvider.UnpackSVrfCoordinator); err != nil {
+		return nil, err
+	}
+	if status.RequestId, err = callView(ctx, contract.client, provider, "vrfRequestId",
+		dailyLotteryVRFProvider.PackVrfRequestId(), dailyLotteryVRFProvider.UnpackVrfRequestId); err != nil {
+		return nil, err
+	}
+	if status.SubId, err = callView(ctx, contract.client, provider, "subId",
+		dailyLotteryVRFProvider.PackSubId(), dailyLotteryVRFProvider.UnpackSubId); err != nil {
+		return nil, err
+	}
+
+	if status.RequestId.Sign() != 0 {
+		values, err := callCoordinator(ctx, contract.client, status.Coordinator, "s_requestCommitments", status.RequestId)
+		if err != nil {
+			return nil, err
+		}
+		status.Commitment = values[0].([32]byte)
+	}
+
+	values, err := callCoordinator(ctx, contract.client, status.Coordinator, "getSubscription", status.SubId)
+	if err != nil {
+		return nil, err
+	}
+	status.Balance, status.NativeBalance = values[0].(*big.Int), values[1].(*big.Int)
+	status.IsConsumer = slices.Contains(values[4].([]common.Address), provider)
+	return status, nil
+}
+
+// callCoordinator 调用 coordinator 的view函数
+func callCoordinator(ctx context.Context, client *eth.Client, coordinator common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := vrfCoordinator.Pack(method, args...)
+	if err != nil {
+		return nil, errorx.Wrap("failed to pack coordinator call", err, "function", method)
+	}
+	res, err := client.Call(ctx, coordinator, method, data)
+	if err != nil {
+		return nil, err
+	}
+	values, err := vrfCoordinator.Unpack(method, res)
+	if err != nil {
+		return nil, errorx.Wrap("failed to unpack result", err, "function", method)
+	}
+	return values, nil
+}
+
+// ReRequestRandomness 调用 provider 的 requestRandomNumbers 重新请求随机数，回调后完成开奖。
+// 始终先模拟执行，provider 仍有未完成的请求时返回 *VRFRequestAlreadyRequestedError，不发送交易
+func (contract *DailyLotteryContract) ReRequestRandomness(ctx context.Context) (*eth.TxResult, error) {
+	provider, err := contract.RandProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := contract.client.SendTransaction(ctx, &eth.TransactionContext{
+		Address:        provider.Hex(),
+		FuncName:       "requestRandomNumbers",
+		Data:           dailyLotteryVRFProvider.PackRequestRandomNumbers(1),
+		Signer:         contract.signer,
+		GasPricer:      contract.gasPricer,
+		GasLimitMargin: contract.config.Gas.GasLimitMargin,
+		Replace:        replacePolicy(contract.config.Gas),
+		Simulate:       true,
+		ErrorAbi:       dailyLotteryErrorABI,
+	})
+	if err != nil {
+		if contractErr := eth.ParseContractError(dailyLotteryErrorABI, err); contractErr != nil {
+			return nil, contract.newDailyLotteryError(ctx, contractErr)
+		}
+		return nil, err
+	}
+	return result, nil
+}
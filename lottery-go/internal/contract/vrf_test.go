@@ -0,0 +1,69 @@
+package contract
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestDailyLotteryContract_VRFStatus(t *testing.T) {
+	provider := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	coordinator := common.HexToAddress("0x00000000000000000000000000000000000000c0")
+
+	subscription := func(t *testing.T, consumers ...common.Address) []byte {
+		data, err := vrfCoordinator.Methods["getSubscription"].Outputs.Pack(big.NewInt(5e18), big.NewInt(0), uint64(3),
+			common.HexToAddress("0x01"), consumers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	newContract := func(t *testing.T, requestId int64, commitment common.Hash, consumers ...common.Address) *DailyLotteryContract {
+		commitmentsCall, _ := vrfCoordinator.Pack("s_requestCommitments", big.NewInt(requestId))
+		subscriptionCall, _ := vrfCoordinator.Pack("getSubscription", big.NewInt(9))
+		return newMockContract(t, map[string][]byte{
+			selector(dailyLottery.PackRandProviderContract()):       common.LeftPadBytes(provider.Bytes(), 32),
+			selector(dailyLotteryVRFProvider.PackSVrfCoordinator()): common.LeftPadBytes(coordinator.Bytes(), 32),
+			selector(dailyLotteryVRFProvider.PackVrfRequestId()):    uint256Result(requestId),
+			selector(dailyLotteryVRFProvider.PackSubId()):           uint256Result(9),
+			selector(commitmentsCall):                               commitment.Bytes(),
+			selector(subscriptionCall):                              subscription(t, consumers...),
+		})
+	}
+
+	tests := []struct {
+		name       string
+		requestId  int64
+		commitment common.Hash
+		consumers  []common.Address
+		pending    bool
+		reRequest  bool
+		diagnosis  string
+	}{
+		{name: "pending", requestId: 42, commitment: common.HexToHash("0x01"), consumers: []common.Address{provider},
+			pending: true, diagnosis: "still pending"},
+		{name: "callback reverted", requestId: 42, consumers: []common.Address{provider}, diagnosis: "callback reverted"},
+		{name: "not consumer", requestId: 42, commitment: common.HexToHash("0x01"), pending: true, diagnosis: "not a consumer"},
+		{name: "no request", consumers: []common.Address{provider}, reRequest: true, diagnosis: "can be re-requested"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := newContract(t, tt.requestId, tt.commitment, tt.consumers...).VRFStatus(t.Context())
+			if err != nil {
+				t.Fatalf("fails to VRFStatus(), %v", err)
+			}
+			if status.Provider != provider || status.Coordinator != coordinator || status.SubId.Int64() != 9 ||
+				status.Balance.Cmp(big.NewInt(5e18)) != 0 {
+				t.Fatalf("unexpected status %+v", status)
+			}
+			if status.RequestPending() != tt.pending || status.CanReRequest() != tt.reRequest {
+				t.Fatalf("expected pending %t, reRequest %t, got %+v", tt.pending, tt.reRequest, status)
+			}
+			if diagnosis := status.Diagnosis(); !strings.Contains(diagnosis, tt.diagnosis) {
+				t.Fatalf("unexpected diagnosis %q", diagnosis)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package job
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+	"lottery-go/internal/config"
+)
+
+func init() {
+	config.Register("job", &ConfigLoader{})
+}
+
+var cfg *Cfg
+
+// =========== config info ===========
+
+type Cfg struct {
+	RecordStore    RecordStoreCfg
+	DrawingTimeout time.Duration // 开奖交易确认后等待随机数回调的最长时间，超时视为开奖卡住
+}
+
+// RecordStoreCfg 任务记录存储配置信息
+type RecordStoreCfg struct {
+	Type       string // 存储类型：bolt、memory
+	Path       string // bolt 数据文件路径
+	RetainDays int    // 任务记录保留天数，<=0 表示不清理
+}
+
+// ========== ConfigLoader ==========
+
+type ConfigLoader struct{}
+
+// Load load job config info
+func (loader *ConfigLoader) Load(conf *viper.Viper) error {
+	conf.SetDefault("recordStore.type", recordStoreBolt)
+	conf.SetDefault("recordStore.path", "data/job.db")
+	conf.SetDefault("recordStore.retainDays", 90)
+	conf.SetDefault("drawingTimeout", 30*time.Minute)
+
+	if err := conf.Unmarshal(&cfg); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -1,78 +1,317 @@
 package job
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"lottery-go/internal/application"
 	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/base/lifecycle"
 	"lottery-go/internal/base/logx"
+	"lottery-go/internal/pkg/alert"
+	"lottery-go/internal/pkg/metrics"
+	"strconv"
+	"sync"
 	"time"
 )
 
+var (
+	// ErrJobRunning 任务正在执行
+	ErrJobRunning = errors.New("job is running")
+	// ErrNotStuck 当天的开奖没有卡住，不需要重新请求随机数
+	ErrNotStuck = errors.New("draw is not stuck")
+)
+
+const (
+	drawLotteryJobName = "drawLotteryJob"
+
+	// 报警原因
+	reasonLotteryNumber = "lotteryNumber"
+	reasonDrawFailed    = "drawFailed"
+	reasonDrawStuck     = "drawStuck"
+	reasonDrawNotLanded = "drawNotLanded"
+
+	// 发送报警的超时时间
+	alarmTimeout = 30 * time.Second
+)
+
 type DrawLotteryJob struct {
-	records         map[string]*Record // 任务记录数据（可持久化，这里简化处理）
+	lc              *lifecycle.Lifecycle
+	store           RecordStore // 任务记录存储，进程重启后可从中断处继续
+	alertManager    *alert.Manager
 	dailyLotteryApp *application.DailyLotteryApplication
-}
 
-type Record struct {
-	lotteryNumber uint64
-	isDrawn       bool
-	tryCount      uint8
+	running sync.Mutex // 保证定时执行和手动触发不会同时开奖
 }
 
-func NewDrawLotteryJob(dailyLotteryApp *application.DailyLotteryApplication) *DrawLotteryJob {
-	records := make(map[string]*Record)
-	return &DrawLotteryJob{records: records, dailyLotteryApp: dailyLotteryApp}
+func NewDrawLotteryJob(lc *lifecycle.Lifecycle, dailyLotteryApp *application.DailyLotteryApplication,
+	store RecordStore, alertManager *alert.Manager) *DrawLotteryJob {
+	return &DrawLotteryJob{lc: lc, store: store, alertManager: alertManager, dailyLotteryApp: dailyLotteryApp}
 }
 
 func (job *DrawLotteryJob) Run() {
+	// 应用停止时取消进行中的RPC调用
+	ctx := job.lc.Context()
+	if ctx.Err() != nil {
+		return
+	}
+
+	// 手动触发的开奖可能正在执行
+	if !job.running.TryLock() {
+		logx.Warn("drawLotteryJob is running, skip.")
+		return
+	}
+	defer job.running.Unlock()
+
+	_, _ = job.run(ctx)
+}
+
+// DrawNow 手动触发一次开奖，不受任务暂停影响，返回当天的任务记录
+func (job *DrawLotteryJob) DrawNow() (*Record, error) {
+	if !job.running.TryLock() {
+		return nil, ErrJobRunning
+	}
+	defer job.running.Unlock()
+
+	return job.run(job.lc.Context())
+}
+
+// Today 当天的任务记录，不存在时返回 nil
+func (job *DrawLotteryJob) Today() (*Record, error) {
+	return job.store.Get(time.Now().Format(time.DateOnly))
+}
+
+// History 按日期倒序返回最近的任务记录
+func (job *DrawLotteryJob) History(limit int) ([]*Record, error) {
+	return job.store.List(limit)
+}
+
+// run 执行开奖逻辑，返回执行后的任务记录；只有获取任务记录失败时才返回error
+func (job *DrawLotteryJob) run(ctx context.Context) (*Record, error) {
+	defer metrics.ObserveJob(drawLotteryJobName, time.Now())
+
 	today := time.Now().Format(time.DateOnly)
 	logx.Info("drawLotteryJob start.", "today", today)
 
-	// 获取当天的任务记录数据，只有获取lotteryNumber时，才会返回error。
-	if record, err := job.getRecord(today); err != nil {
+	// 获取当天的任务记录数据，获取lotteryNumber或读取存储失败时，才会返回error。
+	record, err := job.getRecord(ctx, today)
+	if err != nil {
 		logx.ErrorF("record not found. %v", err)
 
 		// 网络正常情况下，获取lotteryNumber不可能报错，因此触发报警功能
-		job.triggerAlarm()
-	} else {
-		// 如果已经执行成功，则立即返回
-		if record.isDrawn {
-			return
-		}
+		job.triggerAlarm(&alert.Message{Severity: alert.Critical, Title: "failed to get lotteryNumber",
+			Reason: reasonLotteryNumber, Error: err.Error()})
+		return nil, err
+	}
+	job.resolveAlarm(0, "lotteryNumber recovered")
+
+	// 如果已经执行成功，则立即返回
+	if record.IsDrawn {
+		return record, nil
+	}
 
-		// 执行开奖逻辑
-		var suc bool
-		if suc, err = job.dailyLotteryApp.Draw(record.lotteryNumber); err != nil {
-			logx.ErrorF("draw error: %v", err)
-		} else {
-			// 如果开奖成功，则更新任务记录状态
-			if suc {
-				record.isDrawn = true
-				logx.Info("draw success.")
-			}
+	// 执行开奖逻辑
+	result, err := job.dailyLotteryApp.Draw(ctx, record.LotteryNumber)
+	if ctx.Err() != nil {
+		// 应用停止导致的中断不计入尝试次数，但记录已广播的开奖交易，供下次执行和开奖监控计算等待时间
+		logx.Warn("drawLotteryJob canceled.", "lotteryNumber", record.LotteryNumber, "err", err)
+		if result != nil && result.TxHash != "" {
+			record.addSent(result.TxHash)
+			job.saveRecord(record)
+		}
+		return record, nil
+	}
+	if err == nil && result.NotYet != "" {
+		// 合约预判会revert，未发送交易，不计入尝试次数
+		logx.Info("draw not yet.", "lotteryNumber", record.LotteryNumber, "reason", result.NotYet)
+		observeDraw(record.LotteryNumber, result, nil)
+		record.addNotYet(result.NotYet)
+		if saveErr := job.store.Save(record); saveErr != nil {
+			logx.ErrorF("failed to save record. %v", saveErr)
 		}
+		return record, nil
+	}
 
-		record.tryCount++
-		// 如果开奖未完成，且尝试次数达到阈值，则触发业务报警功能
-		if !record.isDrawn && record.tryCount >= 2 {
-			logx.ErrorF("DrawLotteryJob execute fails. retryCount: %d, %v", record.tryCount, err)
-			job.triggerAlarm()
+	if err == nil && result.Drawing {
+		// 等待随机数回调，发送了开奖交易时才计入尝试次数
+		observeDraw(record.LotteryNumber, result, nil)
+		if result.TxHash != "" {
+			record.addAttempt(false, result.TxHash, nil)
 		}
+		job.checkDrawing(ctx, record, time.Now())
+		return record, nil
 	}
-}
 
-func (job *DrawLotteryJob) getRecord(today string) (*Record, error) {
-	if job.records[today] == nil {
-		// 获取当前的lotteryNumber
-		lotteryNumber, err := job.dailyLotteryApp.CurrentLotteryNumber()
+	if err != nil {
+		logx.ErrorF("draw error: %v", err)
+	} else if result.IsDrawn {
+		// 如果开奖成功，则更新任务记录状态
+		logx.Info("draw success.", "txHash", result.TxHash)
+		job.resolveAlarm(record.LotteryNumber, "lottery drawn")
+	}
+
+	observeDraw(record.LotteryNumber, result, err)
+	record.addAttempt(result.IsDrawn, result.TxHash, err)
+	if saveErr := job.store.Save(record); saveErr != nil {
+		logx.ErrorF("failed to save record. %v", saveErr)
+	}
+
+	// 如果开奖未完成，且尝试次数达到阈值或需要人工处理，则触发业务报警功能
+	if !record.IsDrawn && (record.TryCount >= 2 || result.NeedsIntervention) {
+		logx.ErrorF("DrawLotteryJob execute fails. retryCount: %d, %v", record.TryCount, err)
+		msg := &alert.Message{Severity: alert.Critical, Title: "draw lottery failed",
+			LotteryNumber: record.LotteryNumber, Reason: reasonDrawFailed, TryCount: record.TryCount}
 		if err != nil {
-			return nil, errorx.Wrap("failed to get lotteryNumber", err)
+			msg.Error = err.Error()
 		}
-		job.records[today] = &Record{lotteryNumber: lotteryNumber, isDrawn: false, tryCount: 0}
+		job.triggerAlarm(msg)
+	}
+	return record, nil
+}
+
+// checkDrawing 记录开奖中，等待随机数回调超过 drawingTimeout 时标记为卡住，附带VRF请求的诊断信息报警
+func (job *DrawLotteryJob) checkDrawing(ctx context.Context, record *Record, now time.Time) {
+	record.markDrawing(now)
+	waited := now.Sub(record.DrawingSince)
+	if waited < cfg.DrawingTimeout {
+		job.saveRecord(record)
+		return
 	}
 
-	return job.records[today], nil
+	diagnosis := job.diagnose(ctx, waited)
+	record.markStuck(now, diagnosis)
+	job.saveRecord(record)
+
+	logx.Error("draw stuck waiting for vrf callback.", "lotteryNumber", record.LotteryNumber, "waited", waited,
+		"diagnosis", diagnosis)
+	job.triggerAlarm(&alert.Message{Severity: alert.Critical, Title: "draw stuck",
+		LotteryNumber: record.LotteryNumber, Reason: reasonDrawStuck, TryCount: record.TryCount, Error: diagnosis})
 }
 
-func (job *DrawLotteryJob) triggerAlarm() {
-	// todo
+// diagnose 读取 provider 和 coordinator 中VRF请求的状态，说明回调未到达的原因
+func (job *DrawLotteryJob) diagnose(ctx context.Context, waited time.Duration) string {
+	prefix := fmt.Sprintf("no vrf callback after %s", waited.Round(time.Second))
+	status, err := job.dailyLotteryApp.VRFStatus(ctx)
+	if err != nil {
+		return fmt.Sprintf("%s, failed to get vrf status: %v", prefix, err)
+	}
+	return fmt.Sprintf("%s, %s (provider=%s coordinator=%s requestId=%v subId=%v balance=%v nativeBalance=%v consumer=%t)",
+		prefix, status.Diagnosis(), status.Provider.Hex(), status.Coordinator.Hex(), status.RequestId, status.SubId,
+		status.Balance, status.NativeBalance, status.IsConsumer)
+}
+
+// ReRequestRandomness 当天的开奖卡住时重新请求随机数，返回更新后的任务记录；
+// 未卡住时返回 ErrNotStuck，合约不允许重新请求时返回 *contract.VRFRequestAlreadyRequestedError
+func (job *DrawLotteryJob) ReRequestRandomness() (*Record, error) {
+	if !job.running.TryLock() {
+		return nil, ErrJobRunning
+	}
+	defer job.running.Unlock()
+
+	record, err := job.Today()
+	if err != nil {
+		return nil, err
+	}
+	if record == nil || record.State != stateStuck {
+		return record, ErrNotStuck
+	}
+
+	txHash, err := job.dailyLotteryApp.ReRequestRandomness(job.lc.Context(), record.LotteryNumber)
+	if txHash == "" {
+		return record, err
+	}
+
+	logx.Warn("randomness re-requested.", "lotteryNumber", record.LotteryNumber, "txHash", txHash, "err", err)
+	record.addAttempt(false, txHash, err)
+	if err == nil {
+		record.restartDrawing(time.Now())
+	}
+	job.saveRecord(record)
+	return record, err
+}
+
+// observeDraw 记录开奖尝试结果：success 已开奖，pending 开奖中（等待随机数回调），notYet 暂时不能开奖，failure 出错
+func observeDraw(lotteryNumber uint64, result *application.DrawResult, err error) {
+	label := "pending"
+	if err != nil {
+		label = "failure"
+	} else if result.NotYet != "" {
+		label = "notYet"
+	} else if result.IsDrawn {
+		label = "success"
+		metrics.LastDrawSuccess.SetToCurrentTime()
+	}
+	metrics.DrawAttempts.WithLabelValues(strconv.FormatUint(lotteryNumber, 10), label).Inc()
+}
+
+func (job *DrawLotteryJob) getRecord(ctx context.Context, today string) (*Record, error) {
+	record, err := job.store.Get(today)
+	if err != nil {
+		return nil, err
+	}
+	if record != nil {
+		return record, nil
+	}
+
+	// 获取当前的lotteryNumber
+	lotteryNumber, err := job.dailyLotteryApp.CurrentLotteryNumber(ctx)
+	if err != nil {
+		return nil, errorx.Wrap("failed to get lotteryNumber", err)
+	}
+
+	// 立即持久化，保证重启后仍使用当天首次获取的lotteryNumber
+	record = newRecord(today, lotteryNumber)
+	if prev, err := job.store.List(1); err == nil && len(prev) > 0 {
+		record.carryDrawing(prev[0])
+	}
+	if err = job.store.Save(record); err != nil {
+		return nil, err
+	}
+
+	job.pruneRecords()
+	return record, nil
+}
+
+// saveRecord 保存任务记录，失败只记录日志
+func (job *DrawLotteryJob) saveRecord(record *Record) {
+	if err := job.store.Save(record); err != nil {
+		logx.ErrorF("failed to save record. %v", err)
+	}
+}
+
+// pruneRecords 清理超过保留天数的历史记录，每天创建新记录时执行一次
+func (job *DrawLotteryJob) pruneRecords() {
+	before := pruneBefore(time.Now(), cfg.RecordStore.RetainDays)
+	if before == "" {
+		return
+	}
+
+	if count, err := job.store.Prune(before); err != nil {
+		logx.ErrorF("failed to prune records. %v", err)
+	} else if count > 0 {
+		logx.Info("records pruned.", "before", before, "count", count)
+	}
+}
+
+// triggerAlarm 发送报警，相同报警在去重窗口内只发送一次，发送失败只记录日志
+func (job *DrawLotteryJob) triggerAlarm(msg *alert.Message) {
+	msg.Job = drawLotteryJobName
+
+	// 应用停止时仍需发出报警，不使用根 context
+	ctx, cancel := context.WithTimeout(context.Background(), alarmTimeout)
+	defer cancel()
+
+	if err := job.alertManager.Notify(ctx, msg); err != nil {
+		logx.ErrorF("failed to trigger alarm. %v", err)
+	}
+}
+
+// resolveAlarm 对该期号未恢复的报警发送恢复通知
+func (job *DrawLotteryJob) resolveAlarm(lotteryNumber uint64, title string) {
+	ctx, cancel := context.WithTimeout(context.Background(), alarmTimeout)
+	defer cancel()
+
+	if err := job.alertManager.Resolve(ctx, drawLotteryJobName, lotteryNumber, title); err != nil {
+		logx.ErrorF("failed to resolve alarm. %v", err)
+	}
 }
@@ -7,13 +7,29 @@ import (
 
 type RegistryJobs func(c *cron.Cron) error
 
-func NewRegistryJobs(drawLotteryJob *DrawLotteryJob) RegistryJobs {
+func NewRegistryJobs(drawLotteryJob *DrawLotteryJob, watchDrawingJob *WatchDrawingJob, walletBalanceJob *WalletBalanceJob,
+	scratchCardMonitorJob *ScratchCardMonitorJob) RegistryJobs {
 	return func(c *cron.Cron) error {
 		// 天天有奖的开奖任务，任务执行时间：每天凌晨0点，每10分钟执行一次
 		if _, err := c.AddJob("0/10 0 * * *", drawLotteryJob); err != nil {
 			return errorx.Wrap("fails to add job", err, "name", "drawLotteryJob")
 		}
 
+		// 开奖跟踪任务，每5分钟执行一次，等待随机数回调超时或开奖交易未上链时报警
+		if _, err := c.AddJob("@every 5m", watchDrawingJob); err != nil {
+			return errorx.Wrap("fails to add job", err, "name", watchDrawingJobName)
+		}
+
+		// 账户余额采集任务，每5分钟执行一次
+		if _, err := c.AddJob("@every 5m", walletBalanceJob); err != nil {
+			return errorx.Wrap("fails to add job", err, "name", walletBalanceJobName)
+		}
+
+		// 刮刮卡开奖结果监控任务，每分钟执行一次，未配置刮刮卡合约时跳过
+		if _, err := c.AddJob("@every 1m", scratchCardMonitorJob); err != nil {
+			return errorx.Wrap("fails to add job", err, "name", scratchCardMonitorJobName)
+		}
+
 		return nil
 	}
 }
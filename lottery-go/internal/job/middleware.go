@@ -4,6 +4,7 @@ import (
 	"github.com/robfig/cron/v3"
 	"lottery-go/internal/base/logx"
 	"lottery-go/internal/base/stack"
+	"lottery-go/internal/pkg/metrics"
 )
 
 // Recovery 定时任务恢复中间件
@@ -11,6 +12,7 @@ func Recovery(next cron.Job) cron.Job {
 	return cron.FuncJob(func() {
 		defer func() {
 			if err := recover(); err != nil {
+				metrics.JobPanics.Inc()
 				logx.ErrorF("任务发生panic: err: %v, stack: %v", err, stack.GetStackTrace(3))
 			}
 		}()
@@ -2,4 +2,4 @@ package job
 
 import "github.com/google/wire"
 
-var ProviderSet = wire.NewSet(NewRegistryJobs, NewDrawLotteryJob)
+var ProviderSet = wire.NewSet(NewRegistryJobs, NewDrawLotteryJob, NewWatchDrawingJob, NewWalletBalanceJob, NewScratchCardMonitorJob, NewRecordStore, NewSwitch)
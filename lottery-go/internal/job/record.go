@@ -0,0 +1,131 @@
+package job
+
+import "time"
+
+// 开奖状态，未发送开奖交易时为空
+const (
+	stateDrawing = "drawing" // 开奖交易已确认，等待VRF随机数回调
+	stateStuck   = "stuck"   // 等待随机数回调超时，需要人工处理
+	stateDrawn   = "drawn"   // 已开奖
+)
+
+// Record 开奖任务的每日记录
+type Record struct {
+	Day           string    `json:"day"`                   // 记录日期，格式：2006-01-02
+	LotteryNumber uint64    `json:"lotteryNumber"`         // 当天首次执行时获取的期号
+	IsDrawn       bool      `json:"isDrawn"`               // 是否已开奖
+	TryCount      uint8     `json:"tryCount"`              // 已尝试次数
+	TxHashes      []string  `json:"txHashes"`              // 已发送的开奖交易哈希
+	Attempts      []Attempt `json:"attempts"`              // 每次执行的明细
+	State         string    `json:"state,omitempty"`       // 开奖状态：drawing、stuck、drawn
+	DrawingSince  time.Time `json:"drawingSince,omitzero"` // 开奖交易确认（或首次发现开奖中）的时间
+	Diagnosis     string    `json:"diagnosis,omitempty"`   // 等待回调超时时VRF请求的诊断信息
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// Attempt 单次开奖尝试
+type Attempt struct {
+	Time    time.Time `json:"time"`
+	IsDrawn bool      `json:"isDrawn"`
+	TxHash  string    `json:"txHash,omitempty"`
+	Error   string    `json:"error,omitempty"`
+	NotYet  string    `json:"notYet,omitempty"` // 暂时不能开奖的原因，未发送交易
+}
+
+func newRecord(day string, lotteryNumber uint64) *Record {
+	now := time.Now()
+	return &Record{Day: day, LotteryNumber: lotteryNumber, CreatedAt: now, UpdatedAt: now}
+}
+
+// addAttempt 记录一次开奖尝试，并累加尝试次数
+func (record *Record) addAttempt(isDrawn bool, txHash string, err error) {
+	attempt := Attempt{Time: time.Now(), IsDrawn: isDrawn, TxHash: txHash}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+	if txHash != "" {
+		record.TxHashes = append(record.TxHashes, txHash)
+	}
+
+	record.Attempts = append(record.Attempts, attempt)
+	record.TryCount++
+	record.UpdatedAt = attempt.Time
+	if isDrawn {
+		record.markDrawn(attempt.Time)
+	}
+}
+
+// addSent 记录已广播但结果未知（如应用停止）的开奖交易，不累加尝试次数
+func (record *Record) addSent(txHash string) {
+	attempt := Attempt{Time: time.Now(), TxHash: txHash}
+	record.TxHashes = append(record.TxHashes, txHash)
+	record.Attempts = append(record.Attempts, attempt)
+	record.UpdatedAt = attempt.Time
+}
+
+// addNotYet 记录一次暂时不能开奖的执行，未发送交易，不累加尝试次数
+func (record *Record) addNotYet(reason string) {
+	attempt := Attempt{Time: time.Now(), NotYet: reason}
+	record.Attempts = append(record.Attempts, attempt)
+	record.UpdatedAt = attempt.Time
+}
+
+// markDrawing 记录开奖中，保留首次发现开奖中的时间；已卡住时保持卡住状态
+func (record *Record) markDrawing(now time.Time) {
+	if record.DrawingSince.IsZero() {
+		record.DrawingSince = now
+	}
+	if record.State != stateStuck {
+		record.State = stateDrawing
+	}
+	record.UpdatedAt = now
+}
+
+// markStuck 记录等待随机数回调超时
+func (record *Record) markStuck(now time.Time, diagnosis string) {
+	record.State = stateStuck
+	record.Diagnosis = diagnosis
+	record.UpdatedAt = now
+}
+
+// restartDrawing 重新请求随机数后重新开始计时
+func (record *Record) restartDrawing(now time.Time) {
+	record.State = stateDrawing
+	record.DrawingSince = now
+	record.UpdatedAt = now
+}
+
+// resetDrawing 链上仍未开奖（开奖交易未上链或区块被回滚），清除开奖中状态，下次开奖重新计时
+func (record *Record) resetDrawing(now time.Time) {
+	record.State = ""
+	record.DrawingSince = time.Time{}
+	record.Diagnosis = ""
+	record.UpdatedAt = now
+}
+
+// lastSent 最后一次发送了开奖交易的尝试
+func (record *Record) lastSent() (Attempt, bool) {
+	for i := len(record.Attempts) - 1; i >= 0; i-- {
+		if record.Attempts[i].TxHash != "" {
+			return record.Attempts[i], true
+		}
+	}
+	return Attempt{}, false
+}
+
+// markDrawn 记录已开奖
+func (record *Record) markDrawn(now time.Time) {
+	record.IsDrawn = true
+	record.State = stateDrawn
+	record.Diagnosis = ""
+	record.UpdatedAt = now
+}
+
+// carryDrawing 前一天的开奖仍未完成时，新记录沿用其开奖中状态，等待时间从开奖交易开始计算
+func (record *Record) carryDrawing(prev *Record) {
+	if prev == nil || prev.LotteryNumber != record.LotteryNumber || prev.IsDrawn || prev.DrawingSince.IsZero() {
+		return
+	}
+	record.State, record.DrawingSince, record.Diagnosis = prev.State, prev.DrawingSince, prev.Diagnosis
+}
@@ -0,0 +1,54 @@
+package job
+
+import (
+	"lottery-go/internal/base/errorx"
+	"time"
+)
+
+const (
+	recordStoreBolt   = "bolt"
+	recordStoreMemory = "memory"
+)
+
+// RecordStore 任务记录存储，按日期保存开奖任务的执行记录
+type RecordStore interface {
+	// Get 获取指定日期的记录，不存在时返回 nil, nil
+	Get(day string) (*Record, error)
+	// Save 新增或覆盖记录
+	Save(record *Record) error
+	// List 按日期倒序返回最近的记录，limit<=0 表示全部
+	List(limit int) ([]*Record, error)
+	// Prune 删除早于 before（不含）的记录，返回删除条数
+	Prune(before string) (int, error)
+	Close() error
+}
+
+// NewRecordStore 根据配置创建任务记录存储
+func NewRecordStore() (RecordStore, func(), error) {
+	var store RecordStore
+	switch cfg.RecordStore.Type {
+	case recordStoreMemory:
+		store = NewMemoryRecordStore()
+	case recordStoreBolt:
+		boltStore, err := NewBoltRecordStore(cfg.RecordStore.Path)
+		if err != nil {
+			return nil, nil, err
+		}
+		store = boltStore
+	default:
+		return nil, nil, errorx.New("unknown record store type", "type", cfg.RecordStore.Type)
+	}
+
+	cleanup := func() {
+		_ = store.Close()
+	}
+	return store, cleanup, nil
+}
+
+// pruneBefore 根据保留天数计算清理的截止日期，为空表示不清理
+func pruneBefore(now time.Time, retainDays int) string {
+	if retainDays <= 0 {
+		return ""
+	}
+	return now.AddDate(0, 0, -retainDays).Format(time.DateOnly)
+}
@@ -0,0 +1,125 @@
+package job
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"lottery-go/internal/base/errorx"
+)
+
+var drawLotteryBucket = []byte("draw_lottery_records")
+
+// BoltRecordStore 基于 BoltDB 的任务记录存储，key 为日期，value 为 JSON 格式的记录
+type BoltRecordStore struct {
+	db *bolt.DB
+}
+
+func NewBoltRecordStore(path string) (*BoltRecordStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, errorx.Wrap("failed to create record store dir", err, "path", path)
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 3 * time.Second})
+	if err != nil {
+		return nil, errorx.Wrap("failed to open record store", err, "path", path)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(drawLotteryBucket)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, errorx.Wrap("failed to create record bucket", err)
+	}
+
+	return &BoltRecordStore{db: db}, nil
+}
+
+func (store *BoltRecordStore) Get(day string) (*Record, error) {
+	var record *Record
+	err := store.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(drawLotteryBucket).Get([]byte(day))
+		if data == nil {
+			return nil
+		}
+
+		record = &Record{}
+		return json.Unmarshal(data, record)
+	})
+	if err != nil {
+		return nil, errorx.Wrap("failed to get record", err, "day", day)
+	}
+	return record, nil
+}
+
+func (store *BoltRecordStore) Save(record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errorx.Wrap("failed to marshal record", err, "day", record.Day)
+	}
+
+	err = store.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(drawLotteryBucket).Put([]byte(record.Day), data)
+	})
+	if err != nil {
+		return errorx.Wrap("failed to save record", err, "day", record.Day)
+	}
+	return nil
+}
+
+func (store *BoltRecordStore) List(limit int) ([]*Record, error) {
+	records := make([]*Record, 0)
+	err := store.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(drawLotteryBucket).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			if limit > 0 && len(records) >= limit {
+				break
+			}
+
+			record := &Record{}
+			if err := json.Unmarshal(v, record); err != nil {
+				return errorx.Wrap("failed to unmarshal record", err, "day", string(k))
+			}
+			records = append(records, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (store *BoltRecordStore) Prune(before string) (int, error) {
+	count := 0
+	err := store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(drawLotteryBucket)
+
+		// 日期格式可按字典序比较，先收集再删除，避免游标删除时跳过元素
+		var keys [][]byte
+		c := bucket.Cursor()
+		for k, _ := c.First(); k != nil && string(k) < before; k, _ = c.Next() {
+			keys = append(keys, append([]byte(nil), k...))
+		}
+
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		count = len(keys)
+		return nil
+	})
+	if err != nil {
+		return 0, errorx.Wrap("failed to prune records", err, "before", before)
+	}
+	return count, nil
+}
+
+func (store *BoltRecordStore) Close() error {
+	return store.db.Close()
+}
@@ -0,0 +1,82 @@
+package job
+
+import (
+	"sort"
+	"sync"
+)
+
+// MemoryRecordStore 基于内存的任务记录存储，进程重启后数据丢失，用于测试
+type MemoryRecordStore struct {
+	mu      sync.RWMutex
+	records map[string]*Record
+}
+
+func NewMemoryRecordStore() *MemoryRecordStore {
+	return &MemoryRecordStore{records: make(map[string]*Record)}
+}
+
+func (store *MemoryRecordStore) Get(day string) (*Record, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	record, ok := store.records[day]
+	if !ok {
+		return nil, nil
+	}
+	return copyRecord(record), nil
+}
+
+func (store *MemoryRecordStore) Save(record *Record) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.records[record.Day] = copyRecord(record)
+	return nil
+}
+
+func (store *MemoryRecordStore) List(limit int) ([]*Record, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	days := make([]string, 0, len(store.records))
+	for day := range store.records {
+		days = append(days, day)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(days)))
+
+	if limit > 0 && len(days) > limit {
+		days = days[:limit]
+	}
+
+	records := make([]*Record, 0, len(days))
+	for _, day := range days {
+		records = append(records, copyRecord(store.records[day]))
+	}
+	return records, nil
+}
+
+func (store *MemoryRecordStore) Prune(before string) (int, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	count := 0
+	for day := range store.records {
+		if day < before {
+			delete(store.records, day)
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (store *MemoryRecordStore) Close() error {
+	return nil
+}
+
+// copyRecord 复制记录，避免调用方修改存储中的数据
+func copyRecord(record *Record) *Record {
+	c := *record
+	c.TxHashes = append([]string(nil), record.TxHashes...)
+	c.Attempts = append([]Attempt(nil), record.Attempts...)
+	return &c
+}
@@ -0,0 +1,71 @@
+package job
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func testRecordStore(t *testing.T, store RecordStore) {
+	for _, day := range []string{"2025-01-01", "2025-01-02", "2025-01-03"} {
+		record := newRecord(day, 1)
+		record.addAttempt(false, "", errors.New("rpc timeout"))
+		record.addAttempt(true, "0x01", nil)
+		if err := store.Save(record); err != nil {
+			t.Fatalf("fails to Save(), %v", err)
+		}
+	}
+
+	record, err := store.Get("2025-01-02")
+	if err != nil {
+		t.Fatalf("fails to Get(), %v", err)
+	}
+	if record == nil || !record.IsDrawn || record.TryCount != 2 || len(record.TxHashes) != 1 || len(record.Attempts) != 2 {
+		t.Fatalf("unexpected record: %+v", record)
+	}
+
+	if record, err = store.Get("2025-02-01"); err != nil || record != nil {
+		t.Fatalf("expected nil record, got %+v, %v", record, err)
+	}
+
+	records, err := store.List(2)
+	if err != nil {
+		t.Fatalf("fails to List(), %v", err)
+	}
+	if len(records) != 2 || records[0].Day != "2025-01-03" || records[1].Day != "2025-01-02" {
+		t.Fatalf("unexpected records order: %v, %v", records[0].Day, records[1].Day)
+	}
+
+	count, err := store.Prune("2025-01-03")
+	if err != nil || count != 2 {
+		t.Fatalf("expected 2 records pruned, got %d, %v", count, err)
+	}
+	if records, _ = store.List(0); len(records) != 1 {
+		t.Fatalf("expected 1 record left, got %d", len(records))
+	}
+}
+
+func TestMemoryRecordStore(t *testing.T) {
+	testRecordStore(t, NewMemoryRecordStore())
+}
+
+func TestBoltRecordStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "job.db")
+	store, err := NewBoltRecordStore(path)
+	if err != nil {
+		t.Fatalf("fails to NewBoltRecordStore(), %v", err)
+	}
+	testRecordStore(t, store)
+	_ = store.Close()
+
+	// 重新打开后数据仍然存在
+	store, err = NewBoltRecordStore(path)
+	if err != nil {
+		t.Fatalf("fails to reopen store, %v", err)
+	}
+	defer store.Close()
+
+	if record, _ := store.Get("2025-01-03"); record == nil || record.LotteryNumber != 1 {
+		t.Fatalf("record lost after reopen: %+v", record)
+	}
+}
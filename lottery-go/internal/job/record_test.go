@@ -0,0 +1,68 @@
+package job
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecord_DrawingState(t *testing.T) {
+	start := time.Unix(1760000000, 0)
+	record := newRecord("2025-01-01", 7)
+	record.addAttempt(false, "0x01", nil)
+	record.markDrawing(start)
+	record.markDrawing(start.Add(10 * time.Minute))
+	if record.State != stateDrawing || !record.DrawingSince.Equal(start) {
+		t.Fatalf("expected drawing since %v, got %s %v", start, record.State, record.DrawingSince)
+	}
+
+	// 卡住后再次检查仍保持卡住，重新请求随机数后重新计时
+	record.markStuck(start.Add(40*time.Minute), "no vrf callback")
+	record.markDrawing(start.Add(45 * time.Minute))
+	if record.State != stateStuck || record.Diagnosis == "" {
+		t.Fatalf("expected stuck, got %s", record.State)
+	}
+	record.restartDrawing(start.Add(50 * time.Minute))
+	if record.State != stateDrawing || !record.DrawingSince.Equal(start.Add(50*time.Minute)) {
+		t.Fatalf("expected drawing restarted, got %s %v", record.State, record.DrawingSince)
+	}
+
+	// 次日期号未变时沿用开奖中状态，期号变化或已开奖时不沿用
+	next := newRecord("2025-01-02", 7)
+	next.carryDrawing(record)
+	if next.State != stateDrawing || !next.DrawingSince.Equal(record.DrawingSince) {
+		t.Fatalf("expected drawing state carried over, got %+v", next)
+	}
+	other := newRecord("2025-01-02", 8)
+	other.carryDrawing(record)
+	if other.State != "" || !other.DrawingSince.IsZero() {
+		t.Fatalf("unexpected state carried over to another lottery, got %+v", other)
+	}
+
+	// 应用停止时已广播的开奖交易只记录，不计入尝试次数
+	record.addSent("0x02")
+	if sent, ok := record.lastSent(); !ok || sent.TxHash != "0x02" || record.TryCount != 1 || len(record.TxHashes) != 2 {
+		t.Fatalf("unexpected sent record %+v", record)
+	}
+
+	// 链上仍未开奖时清除开奖中状态，最后发送的开奖交易用于判断是否未上链
+	record.addNotYet("MinDrawIntervalNotMet")
+	if sent, ok := record.lastSent(); !ok || sent.TxHash != "0x02" {
+		t.Fatalf("unexpected last sent attempt %+v", sent)
+	}
+	record.resetDrawing(start.Add(55 * time.Minute))
+	if record.State != "" || !record.DrawingSince.IsZero() {
+		t.Fatalf("expected drawing state cleared, got %s %v", record.State, record.DrawingSince)
+	}
+	if _, ok := newRecord("2025-01-03", 7).lastSent(); ok {
+		t.Fatal("unexpected sent attempt in new record")
+	}
+
+	record.markDrawn(start.Add(time.Hour))
+	if !record.IsDrawn || record.State != stateDrawn || record.Diagnosis != "" {
+		t.Fatalf("expected drawn, got %+v", record)
+	}
+	next = newRecord("2025-01-02", 7)
+	if next.carryDrawing(record); next.State != "" {
+		t.Fatalf("unexpected state carried over from drawn record, got %s", next.State)
+	}
+}
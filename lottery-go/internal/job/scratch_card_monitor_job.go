@@ -0,0 +1,95 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"lottery-go/internal/application"
+	"lottery-go/internal/base/lifecycle"
+	"lottery-go/internal/base/logx"
+	"lottery-go/internal/pkg/alert"
+	"lottery-go/internal/pkg/eth"
+	"lottery-go/internal/pkg/metrics"
+)
+
+const (
+	scratchCardMonitorJobName = "scratchCardMonitorJob"
+
+	// 报警原因
+	reasonResultMissing = "resultMissing"
+
+	// 报警中列出的超时购买数量上限
+	maxStuckInAlarm = 5
+)
+
+// ScratchCardMonitorJob 定期检查刮刮卡购买是否收到开奖结果，随机数回调丢失时用户已付款却没有结果
+type ScratchCardMonitorJob struct {
+	lc             *lifecycle.Lifecycle
+	alertManager   *alert.Manager
+	scratchCardApp *application.ScratchCardApplication
+}
+
+func NewScratchCardMonitorJob(lc *lifecycle.Lifecycle, scratchCardApp *application.ScratchCardApplication,
+	alertManager *alert.Manager) *ScratchCardMonitorJob {
+	return &ScratchCardMonitorJob{lc: lc, alertManager: alertManager, scratchCardApp: scratchCardApp}
+}
+
+func (job *ScratchCardMonitorJob) Run() {
+	ctx := job.lc.Context()
+	if ctx.Err() != nil || !job.scratchCardApp.Enabled() {
+		return
+	}
+	defer metrics.ObserveJob(scratchCardMonitorJobName, time.Now())
+
+	check, err := job.scratchCardApp.CheckResults(ctx, time.Now())
+	if err != nil {
+		logx.ErrorF("failed to check scratch card results. %v", err)
+		return
+	}
+	metrics.ScratchCardPending.WithLabelValues("pending").Set(float64(check.Pending))
+	metrics.ScratchCardPending.WithLabelValues("stuck").Set(float64(len(check.Stuck)))
+
+	if len(check.Stuck) == 0 {
+		job.resolveAlarm("scratch card results received")
+		return
+	}
+
+	logx.Error("scratch card results missing.", "stuck", len(check.Stuck), "pending", check.Pending,
+		"oldest", check.Stuck[0].TxHash.Hex())
+	job.triggerAlarm(&alert.Message{Severity: alert.Critical, Title: "scratch card result missing",
+		Reason: reasonResultMissing, Error: describeStuck(check.Stuck)})
+}
+
+// describeStuck 列出最早的几笔超时购买
+func describeStuck(stuck []*application.ScratchCardRequest) string {
+	lines := []string{fmt.Sprintf("%d purchases without LotteryResultEvent", len(stuck))}
+	for _, request := range stuck[:min(len(stuck), maxStuckInAlarm)] {
+		lines = append(lines, fmt.Sprintf("user=%s value=%gETH time=%s txHash=%s", request.User.Hex(),
+			eth.WeiToEther(request.Value), request.Time.Format(time.DateTime), request.TxHash.Hex()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// triggerAlarm 发送报警，相同报警在去重窗口内只发送一次，发送失败只记录日志
+func (job *ScratchCardMonitorJob) triggerAlarm(msg *alert.Message) {
+	msg.Job = scratchCardMonitorJobName
+
+	ctx, cancel := context.WithTimeout(context.Background(), alarmTimeout)
+	defer cancel()
+
+	if err := job.alertManager.Notify(ctx, msg); err != nil {
+		logx.ErrorF("failed to trigger alarm. %v", err)
+	}
+}
+
+// resolveAlarm 所有超时的购买都已收到开奖结果时发送恢复通知
+func (job *ScratchCardMonitorJob) resolveAlarm(title string) {
+	ctx, cancel := context.WithTimeout(context.Background(), alarmTimeout)
+	defer cancel()
+
+	if err := job.alertManager.Resolve(ctx, scratchCardMonitorJobName, 0, title); err != nil {
+		logx.ErrorF("failed to resolve alarm. %v", err)
+	}
+}
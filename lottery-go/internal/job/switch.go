@@ -0,0 +1,42 @@
+package job
+
+import (
+	"github.com/robfig/cron/v3"
+	"lottery-go/internal/base/logx"
+	"sync/atomic"
+)
+
+// Switch 定时任务开关，暂停后跳过所有定时任务，手动触发不受影响
+type Switch struct {
+	paused atomic.Bool
+}
+
+func NewSwitch() *Switch {
+	return &Switch{}
+}
+
+func (s *Switch) Pause() {
+	s.paused.Store(true)
+	logx.Warn("scheduled jobs paused.")
+}
+
+func (s *Switch) Resume() {
+	s.paused.Store(false)
+	logx.Info("scheduled jobs resumed.")
+}
+
+func (s *Switch) Paused() bool {
+	return s.paused.Load()
+}
+
+// SkipIfPaused 定时任务暂停中间件
+func (s *Switch) SkipIfPaused(next cron.Job) cron.Job {
+	return cron.FuncJob(func() {
+		if s.Paused() {
+			logx.Info("scheduled jobs paused, skip.")
+			return
+		}
+
+		next.Run()
+	})
+}
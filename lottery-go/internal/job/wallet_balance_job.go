@@ -0,0 +1,38 @@
+package job
+
+import (
+	"time"
+
+	"lottery-go/internal/application"
+	"lottery-go/internal/base/lifecycle"
+	"lottery-go/internal/base/logx"
+	"lottery-go/internal/pkg/eth"
+	"lottery-go/internal/pkg/metrics"
+)
+
+const walletBalanceJobName = "walletBalanceJob"
+
+// WalletBalanceJob 定期采集发送开奖交易账户的余额，余额不足会导致开奖失败
+type WalletBalanceJob struct {
+	lc              *lifecycle.Lifecycle
+	dailyLotteryApp *application.DailyLotteryApplication
+}
+
+func NewWalletBalanceJob(lc *lifecycle.Lifecycle, dailyLotteryApp *application.DailyLotteryApplication) *WalletBalanceJob {
+	return &WalletBalanceJob{lc: lc, dailyLotteryApp: dailyLotteryApp}
+}
+
+func (job *WalletBalanceJob) Run() {
+	ctx := job.lc.Context()
+	if ctx.Err() != nil {
+		return
+	}
+	defer metrics.ObserveJob(walletBalanceJobName, time.Now())
+
+	operator, balance, err := job.dailyLotteryApp.OperatorBalance(ctx)
+	if err != nil {
+		logx.ErrorF("failed to get wallet balance. %v", err)
+		return
+	}
+	metrics.WalletBalance.WithLabelValues(operator.Hex()).Set(eth.WeiToEther(balance))
+}
@@ -0,0 +1,86 @@
+package job
+
+import (
+	"fmt"
+	"time"
+
+	"lottery-go/internal/base/lifecycle"
+	"lottery-go/internal/base/logx"
+	"lottery-go/internal/contract"
+	"lottery-go/internal/pkg/alert"
+	"lottery-go/internal/pkg/metrics"
+)
+
+const watchDrawingJobName = "watchDrawingJob"
+
+// WatchDrawingJob 定期检查当天已发送开奖交易的记录：已开奖时更新状态并恢复报警，
+// 等待随机数回调超时、或开奖交易始终没有上链时报警。开奖任务只在凌晨执行，开奖卡住后由该任务继续跟踪
+type WatchDrawingJob struct {
+	lc             *lifecycle.Lifecycle
+	drawLotteryJob *DrawLotteryJob
+}
+
+func NewWatchDrawingJob(lc *lifecycle.Lifecycle, drawLotteryJob *DrawLotteryJob) *WatchDrawingJob {
+	return &WatchDrawingJob{lc: lc, drawLotteryJob: drawLotteryJob}
+}
+
+func (job *WatchDrawingJob) Run() {
+	ctx := job.lc.Context()
+	if ctx.Err() != nil {
+		return
+	}
+
+	// 开奖任务执行时由它更新记录
+	draw := job.drawLotteryJob
+	if !draw.running.TryLock() {
+		return
+	}
+	defer draw.running.Unlock()
+	defer metrics.ObserveJob(watchDrawingJobName, time.Now())
+
+	record, err := draw.Today()
+	if err != nil {
+		logx.ErrorF("failed to get record. %v", err)
+		return
+	}
+	if record == nil || record.IsDrawn {
+		return
+	}
+	sent, hasSent := record.lastSent()
+	if record.State == "" && !hasSent {
+		return
+	}
+
+	state, err := draw.dailyLotteryApp.DrawState(ctx, record.LotteryNumber)
+	if err != nil {
+		logx.ErrorF("failed to get draw state. %v", err)
+		return
+	}
+	now := time.Now()
+	switch state {
+	case contract.Drawn:
+		logx.Info("draw success.", "lotteryNumber", record.LotteryNumber, "waited", now.Sub(record.DrawingSince))
+		metrics.LastDrawSuccess.SetToCurrentTime()
+		record.markDrawn(now)
+		draw.saveRecord(record)
+		draw.resolveAlarm(record.LotteryNumber, "lottery drawn")
+	case contract.Drawing:
+		draw.checkDrawing(ctx, record, now)
+	case contract.NotDrawn:
+		// 开奖交易可能仍在内存池中，超过 drawingTimeout 仍未上链时报警
+		if !hasSent || now.Sub(sent.Time) < cfg.DrawingTimeout {
+			return
+		}
+		if record.State != "" {
+			record.resetDrawing(now)
+			draw.saveRecord(record)
+		}
+
+		logx.Error("draw transaction not landed.", "lotteryNumber", record.LotteryNumber, "txHash", sent.TxHash,
+			"sentAt", sent.Time.Format(time.DateTime))
+		draw.triggerAlarm(&alert.Message{Severity: alert.Critical, Title: "draw transaction not landed",
+			LotteryNumber: record.LotteryNumber, Reason: reasonDrawNotLanded, TryCount: record.TryCount,
+			Error: fmt.Sprintf("lottery still not drawn %s after draw transaction %s, draw again or cancel it",
+				now.Sub(sent.Time).Round(time.Second), sent.TxHash)})
+	}
+}
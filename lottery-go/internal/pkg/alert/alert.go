@@ -0,0 +1,144 @@
+// Package alert 提供业务报警功能，支持 webhook、Slack/钉钉/飞书机器人、Telegram 和邮件等渠道
+package alert
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"text/template"
+	"time"
+
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/base/logx"
+)
+
+// Severity 报警级别
+type Severity int
+
+const (
+	Info Severity = iota
+	Warning
+	Critical
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Info:
+		return "info"
+	case Warning:
+		return "warning"
+	case Critical:
+		return "critical"
+	}
+	return "unknown"
+}
+
+// ParseSeverity 解析报警级别，为空时返回 Info
+func ParseSeverity(s string) (Severity, error) {
+	switch strings.ToLower(s) {
+	case "", "info":
+		return Info, nil
+	case "warning", "warn":
+		return Warning, nil
+	case "critical":
+		return Critical, nil
+	}
+	return Info, errorx.New("unknown alert severity", "severity", s)
+}
+
+// Message 报警消息
+type Message struct {
+	Severity      Severity
+	Title         string
+	Job           string
+	LotteryNumber uint64
+	Reason        string // 报警原因，与 Job、LotteryNumber 一起用于去重
+	TryCount      uint8
+	Error         string
+	Time          time.Time
+	Repeat        int  // 相同报警的累计触发次数
+	Resolved      bool // 是否为恢复通知
+}
+
+// Notifier 报警通知渠道
+type Notifier interface {
+	Notify(ctx context.Context, msg *Message) error
+}
+
+const defaultTemplate = `{{if .Resolved}}[resolved]{{else}}[{{.Severity}}]{{end}} {{.Title}}
+job: {{.Job}}
+lotteryNumber: {{.LotteryNumber}}
+tryCount: {{.TryCount}}
+{{- if gt .Repeat 1}}
+repeat: {{.Repeat}}
+{{- end}}
+{{- if .Error}}
+error: {{.Error}}
+{{- end}}
+time: {{.Time.Format "2006-01-02 15:04:05"}}`
+
+// parseTemplate 解析消息模板，为空时使用默认模板
+func parseTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		text = defaultTemplate
+	}
+
+	tpl, err := template.New("alert").Parse(text)
+	if err != nil {
+		return nil, errorx.Wrap("failed to parse alert template", err)
+	}
+	return tpl, nil
+}
+
+func render(tpl *template.Template, msg *Message) (string, error) {
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, msg); err != nil {
+		return "", errorx.Wrap("failed to render alert message", err)
+	}
+	return buf.String(), nil
+}
+
+// channel 报警渠道，低于 minSeverity 的消息不发送
+type channel struct {
+	name        string
+	minSeverity Severity
+	notifier    Notifier
+}
+
+// MultiNotifier 将报警消息分发到所有满足级别的渠道
+type MultiNotifier struct {
+	channels []channel
+}
+
+func NewMultiNotifier() *MultiNotifier {
+	return &MultiNotifier{}
+}
+
+// Add 添加报警渠道
+func (m *MultiNotifier) Add(name string, minSeverity Severity, notifier Notifier) {
+	m.channels = append(m.channels, channel{name: name, minSeverity: minSeverity, notifier: notifier})
+}
+
+func (m *MultiNotifier) Notify(ctx context.Context, msg *Message) error {
+	if msg.Time.IsZero() {
+		msg.Time = time.Now()
+	}
+	logx.Warn("alert triggered.", "severity", msg.Severity.String(), "title", msg.Title, "job", msg.Job,
+		"lotteryNumber", msg.LotteryNumber, "reason", msg.Reason, "tryCount", msg.TryCount,
+		"repeat", msg.Repeat, "resolved", msg.Resolved, "error", msg.Error)
+
+	var errs []error
+	for _, ch := range m.channels {
+		// 恢复通知发送到所有渠道
+		if !msg.Resolved && msg.Severity < ch.minSeverity {
+			continue
+		}
+
+		if err := ch.notifier.Notify(ctx, msg); err != nil {
+			logx.ErrorF("failed to send alert. channel: %s, %v", ch.name, err)
+			errs = append(errs, errorx.Wrap("failed to send alert", err, "channel", ch.name))
+		}
+	}
+	return errors.Join(errs...)
+}
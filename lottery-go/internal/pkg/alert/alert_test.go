@@ -0,0 +1,98 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+	"testing"
+
+	"lottery-go/internal/pkg/alert/alerttest"
+)
+
+func testMessage() *Message {
+	return &Message{Severity: Critical, Title: "draw failed", Job: "drawLotteryJob", LotteryNumber: 7, TryCount: 2, Error: "rpc timeout"}
+}
+
+func TestMultiNotifier_Channels(t *testing.T) {
+	server := alerttest.NewServer()
+	defer server.Close()
+
+	tpl, _ := parseTemplate("")
+	robot, _ := NewRobotNotifier(RobotDingTalk, server.URL+"/dingtalk", tpl)
+
+	multi := NewMultiNotifier()
+	multi.Add("webhook", Info, NewWebhookNotifier(server.URL+"/webhook", tpl))
+	multi.Add("dingtalk", Critical, robot)
+	multi.Add("telegram", Warning, NewTelegramNotifier(server.URL, "token", "42", tpl))
+
+	// warning 级别不发送到只接收 critical 的钉钉渠道
+	msg := testMessage()
+	msg.Severity = Warning
+	if err := multi.Notify(context.Background(), msg); err != nil {
+		t.Fatalf("fails to Notify(), %v", err)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 2 || requests[0].Path != "/webhook" || requests[1].Path != "/bottoken/sendMessage" {
+		t.Fatalf("unexpected requests: %+v", requests)
+	}
+
+	var body webhookBody
+	if err := json.Unmarshal(requests[0].Body, &body); err != nil {
+		t.Fatalf("fails to unmarshal webhook body, %v", err)
+	}
+	if body.LotteryNumber != 7 || body.TryCount != 2 || !strings.Contains(body.Text, "error: rpc timeout") {
+		t.Fatalf("unexpected webhook body: %+v", body)
+	}
+
+	var telegram map[string]string
+	_ = json.Unmarshal(requests[1].Body, &telegram)
+	if telegram["chat_id"] != "42" || !strings.HasPrefix(telegram["text"], "[warning] draw failed") {
+		t.Fatalf("unexpected telegram body: %v", telegram)
+	}
+}
+
+func TestRobotNotifier_Error(t *testing.T) {
+	server := alerttest.NewServer()
+	defer server.Close()
+	server.Respond(http.StatusOK, `{"errcode":310000,"errmsg":"keywords not in content"}`)
+
+	tpl, _ := parseTemplate("{{.Title}} #{{.LotteryNumber}}")
+	robot, _ := NewRobotNotifier(RobotDingTalk, server.URL, tpl)
+	if err := robot.Notify(context.Background(), testMessage()); err == nil {
+		t.Fatal("expected dingtalk error")
+	}
+
+	var body struct {
+		Text struct {
+			Content string `json:"content"`
+		} `json:"text"`
+	}
+	_ = json.Unmarshal(server.Requests()[0].Body, &body)
+	if body.Text.Content != "draw failed #7" {
+		t.Fatalf("unexpected content: %s", body.Text.Content)
+	}
+}
+
+func TestEmailNotifier_EncodeSubject(t *testing.T) {
+	n := NewEmailNotifier(&EmailCfg{From: "bot@example.com", To: []string{"ops@example.com"}}, nil)
+
+	for _, subject := range []string{"[critical] draw failed", "[critical] 开奖失败"} {
+		var header string
+		for _, line := range strings.Split(n.message(subject, "body"), "\r\n") {
+			if value, ok := strings.CutPrefix(line, "Subject: "); ok {
+				header = value
+			}
+		}
+		for _, r := range header {
+			if r > 127 {
+				t.Fatalf("expected ascii subject header, got %q", header)
+			}
+		}
+		if decoded, err := new(mime.WordDecoder).DecodeHeader(header); err != nil || decoded != subject {
+			t.Fatalf("expected subject %q, got %q, %v", subject, decoded, err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Package alerttest 提供本地HTTP服务，替代 webhook、机器人、Telegram 等报警接口用于测试
+package alerttest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// Request 收到的报警请求
+type Request struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// Server 记录所有收到的请求，并返回预设的响应
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []Request
+	status   int
+	response string
+}
+
+// NewServer 启动本地服务，默认返回 200 和 Telegram 风格的成功响应
+func NewServer() *Server {
+	s := &Server{status: http.StatusOK, response: `{"ok":true,"errcode":0,"code":0}`}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Respond 设置后续请求的响应
+func (s *Server) Respond(status int, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.status = status
+	s.response = body
+}
+
+// Requests 已收到的请求
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Body: body})
+	status, response := s.status, s.response
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(response))
+}
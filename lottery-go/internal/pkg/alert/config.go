@@ -0,0 +1,117 @@
+package alert
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/config"
+)
+
+func init() {
+	config.Register("alert", &ConfigLoader{})
+}
+
+var cfg *Cfg
+
+// 渠道类型
+const (
+	typeWebhook  = "webhook"
+	typeTelegram = "telegram"
+	typeEmail    = "email"
+)
+
+// =========== config info ===========
+
+type Cfg struct {
+	DedupWindow   time.Duration // 去重窗口，窗口内相同报警只发送一次
+	EscalateAfter int           // 相同报警触发次数达到该值时发送到升级渠道，<=0 表示不升级
+	Channels      []ChannelCfg
+}
+
+// ChannelCfg 报警渠道配置
+type ChannelCfg struct {
+	Name        string
+	Type        string // webhook、slack、dingtalk、feishu、telegram、email
+	MinSeverity string // 最低报警级别：info、warning、critical
+	Template    string // 消息模板（text/template），为空时使用默认模板
+	Url         string // webhook、机器人地址
+	Escalation  bool   // 是否为升级渠道，升级渠道只接收升级后的报警及其恢复通知
+	Telegram    TelegramCfg
+	Email       EmailCfg
+}
+
+type TelegramCfg struct {
+	ApiUrl string // 为空时使用官方地址
+	Token  string
+	ChatId string
+}
+
+type EmailCfg struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// ========== ConfigLoader ==========
+
+type ConfigLoader struct{}
+
+// Load load alert config info
+func (loader *ConfigLoader) Load(conf *viper.Viper) error {
+	conf.SetDefault("dedupWindow", "1h")
+	conf.SetDefault("escalateAfter", 3)
+
+	if err := conf.Unmarshal(&cfg); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewAlertManager 根据配置创建报警管理器，未配置渠道时只打印日志
+func NewAlertManager() (*Manager, error) {
+	notifier, escalation := NewMultiNotifier(), NewMultiNotifier()
+	for i := range cfg.Channels {
+		conf := &cfg.Channels[i]
+
+		minSeverity, err := ParseSeverity(conf.MinSeverity)
+		if err != nil {
+			return nil, err
+		}
+
+		channelNotifier, err := newChannelNotifier(conf)
+		if err != nil {
+			return nil, errorx.Wrap("failed to create alert channel", err, "name", conf.Name)
+		}
+
+		if conf.Escalation {
+			escalation.Add(conf.Name, minSeverity, channelNotifier)
+		} else {
+			notifier.Add(conf.Name, minSeverity, channelNotifier)
+		}
+	}
+	return NewManager(notifier, escalation, cfg.DedupWindow, cfg.EscalateAfter), nil
+}
+
+func newChannelNotifier(conf *ChannelCfg) (Notifier, error) {
+	tpl, err := parseTemplate(conf.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	switch conf.Type {
+	case typeWebhook:
+		return NewWebhookNotifier(conf.Url, tpl), nil
+	case RobotSlack, RobotDingTalk, RobotFeishu:
+		return NewRobotNotifier(conf.Type, conf.Url, tpl)
+	case typeTelegram:
+		return NewTelegramNotifier(conf.Telegram.ApiUrl, conf.Telegram.Token, conf.Telegram.ChatId, tpl), nil
+	case typeEmail:
+		return NewEmailNotifier(&conf.Email, tpl), nil
+	}
+	return nil, errorx.New("unknown alert channel type", "type", conf.Type)
+}
@@ -0,0 +1,81 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"lottery-go/internal/base/errorx"
+)
+
+// EmailNotifier 通过 SMTP 发送报警邮件
+type EmailNotifier struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+	to       []string
+	tpl      *template.Template
+}
+
+func NewEmailNotifier(conf *EmailCfg, tpl *template.Template) *EmailNotifier {
+	return &EmailNotifier{
+		host:     conf.Host,
+		port:     conf.Port,
+		username: conf.Username,
+		password: conf.Password,
+		from:     conf.From,
+		to:       conf.To,
+		tpl:      tpl,
+	}
+}
+
+func (n *EmailNotifier) Notify(ctx context.Context, msg *Message) error {
+	text, err := render(n.tpl, msg)
+	if err != nil {
+		return err
+	}
+
+	body := n.message(fmt.Sprintf("[%s] %s", msg.Severity, msg.Title), text)
+
+	var auth smtp.Auth
+	if n.username != "" {
+		auth = smtp.PlainAuth("", n.username, n.password, n.host)
+	}
+
+	// smtp.SendMail 不支持 context，放到协程中执行以便及时返回
+	addr := net.JoinHostPort(n.host, strconv.Itoa(n.port))
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(addr, auth, n.from, n.to, []byte(body))
+	}()
+
+	select {
+	case err = <-done:
+		if err != nil {
+			return errorx.Wrap("failed to send alert email", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// message 组装邮件内容，标题按 RFC 2047 编码，避免中文等非ASCII字符产生非法的邮件头
+func (n *EmailNotifier) message(subject, text string) string {
+	return strings.Join([]string{
+		"From: " + n.from,
+		"To: " + strings.Join(n.to, ","),
+		"Subject: " + mime.QEncoding.Encode("utf-8", subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		text,
+	}, "\r\n")
+}
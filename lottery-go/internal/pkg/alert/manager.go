@@ -0,0 +1,121 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"lottery-go/internal/base/logx"
+)
+
+// Key 报警去重的维度
+type Key struct {
+	Job           string
+	LotteryNumber uint64
+	Reason        string
+}
+
+// state 单个报警的状态
+type state struct {
+	msg        Message
+	count      int       // 累计触发次数
+	lastSentAt time.Time // 最近一次发送时间
+	escalated  bool      // 是否已升级
+}
+
+// Manager 有状态的报警管理：去重窗口内相同报警只发送一次，
+// 重复次数达到阈值后升级到升级渠道，问题解决后发送恢复通知
+type Manager struct {
+	notifier      Notifier // 常规渠道
+	escalation    Notifier // 升级渠道
+	dedupWindow   time.Duration
+	escalateAfter int // 触发次数达到该值时升级，<=0 表示不升级
+
+	mu     sync.Mutex
+	states map[Key]*state
+	now    func() time.Time
+}
+
+func NewManager(notifier Notifier, escalation Notifier, dedupWindow time.Duration, escalateAfter int) *Manager {
+	return &Manager{
+		notifier:      notifier,
+		escalation:    escalation,
+		dedupWindow:   dedupWindow,
+		escalateAfter: escalateAfter,
+		states:        make(map[Key]*state),
+		now:           time.Now,
+	}
+}
+
+// Notify 触发报警，实现 Notifier 接口
+func (m *Manager) Notify(ctx context.Context, msg *Message) error {
+	key := Key{Job: msg.Job, LotteryNumber: msg.LotteryNumber, Reason: msg.Reason}
+	now := m.now()
+	if msg.Time.IsZero() {
+		msg.Time = now
+	}
+
+	m.mu.Lock()
+	st, ok := m.states[key]
+	if !ok {
+		st = &state{}
+		m.states[key] = st
+	}
+	st.count++
+	st.msg = *msg
+	msg.Repeat = st.count
+
+	escalate := m.escalation != nil && m.escalateAfter > 0 && !st.escalated && st.count >= m.escalateAfter
+	send := escalate || st.lastSentAt.IsZero() || now.Sub(st.lastSentAt) >= m.dedupWindow
+	if send {
+		st.lastSentAt = now
+	}
+	if escalate {
+		st.escalated = true
+	}
+	m.mu.Unlock()
+
+	if !send {
+		logx.Info("alert suppressed.", "job", key.Job, "lotteryNumber", key.LotteryNumber,
+			"reason", key.Reason, "repeat", msg.Repeat)
+		return nil
+	}
+
+	err := m.notifier.Notify(ctx, msg)
+	if escalate {
+		logx.Warn("alert escalated.", "job", key.Job, "lotteryNumber", key.LotteryNumber,
+			"reason", key.Reason, "repeat", msg.Repeat)
+		err = errors.Join(err, m.escalation.Notify(ctx, msg))
+	}
+	return err
+}
+
+// Resolve 问题已解决，对指定任务和期号下所有未恢复的报警发送恢复通知
+func (m *Manager) Resolve(ctx context.Context, job string, lotteryNumber uint64, title string) error {
+	m.mu.Lock()
+	var resolved []*state
+	for key, st := range m.states {
+		if key.Job == job && key.LotteryNumber == lotteryNumber {
+			resolved = append(resolved, st)
+			delete(m.states, key)
+		}
+	}
+	m.mu.Unlock()
+
+	var errs []error
+	for _, st := range resolved {
+		msg := st.msg
+		msg.Title = title
+		msg.Error = ""
+		msg.Repeat = st.count
+		msg.Resolved = true
+		msg.Time = m.now()
+
+		errs = append(errs, m.notifier.Notify(ctx, &msg))
+		if st.escalated {
+			errs = append(errs, m.escalation.Notify(ctx, &msg))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,60 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type recordNotifier struct {
+	messages []Message
+}
+
+func (n *recordNotifier) Notify(_ context.Context, msg *Message) error {
+	n.messages = append(n.messages, *msg)
+	return nil
+}
+
+func TestManager_DedupEscalateResolve(t *testing.T) {
+	primary, escalation := &recordNotifier{}, &recordNotifier{}
+	manager := NewManager(primary, escalation, time.Hour, 3)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	manager.now = func() time.Time { return now }
+
+	fire := func() {
+		msg := &Message{Severity: Critical, Title: "draw failed", Job: "drawLotteryJob", LotteryNumber: 7, Reason: "drawFailed"}
+		if err := manager.Notify(context.Background(), msg); err != nil {
+			t.Fatalf("fails to Notify(), %v", err)
+		}
+		now = now.Add(10 * time.Minute)
+	}
+
+	// 第1次发送，第2次在去重窗口内被抑制，第3次升级
+	fire()
+	fire()
+	if len(primary.messages) != 1 || len(escalation.messages) != 0 {
+		t.Fatalf("expected 1 primary alert, got %d/%d", len(primary.messages), len(escalation.messages))
+	}
+	fire()
+	if len(primary.messages) != 2 || len(escalation.messages) != 1 || escalation.messages[0].Repeat != 3 {
+		t.Fatalf("expected escalation on 3rd repeat, got %d/%d", len(primary.messages), len(escalation.messages))
+	}
+
+	// 其他期号的恢复不影响当前报警
+	_ = manager.Resolve(context.Background(), "drawLotteryJob", 8, "lottery drawn")
+	if len(primary.messages) != 2 {
+		t.Fatalf("unexpected resolve for other lottery number")
+	}
+
+	_ = manager.Resolve(context.Background(), "drawLotteryJob", 7, "lottery drawn")
+	if len(primary.messages) != 3 || !primary.messages[2].Resolved || len(escalation.messages) != 2 {
+		t.Fatalf("expected resolved notifications on both channels, got %d/%d", len(primary.messages), len(escalation.messages))
+	}
+
+	// 恢复后再次触发视为新的报警
+	fire()
+	if len(primary.messages) != 4 || primary.messages[3].Repeat != 1 {
+		t.Fatalf("expected new alert after resolve")
+	}
+}
@@ -0,0 +1,5 @@
+package alert
+
+import "github.com/google/wire"
+
+var ProviderSet = wire.NewSet(NewAlertManager)
@@ -0,0 +1,54 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"lottery-go/internal/base/errorx"
+)
+
+const defaultTelegramApiUrl = "https://api.telegram.org"
+
+// TelegramNotifier 通过 Telegram Bot API 发送消息
+type TelegramNotifier struct {
+	apiUrl string
+	token  string
+	chatID string
+	tpl    *template.Template
+}
+
+// NewTelegramNotifier apiUrl 为空时使用官方地址
+func NewTelegramNotifier(apiUrl string, token string, chatID string, tpl *template.Template) *TelegramNotifier {
+	if apiUrl == "" {
+		apiUrl = defaultTelegramApiUrl
+	}
+	return &TelegramNotifier{apiUrl: strings.TrimRight(apiUrl, "/"), token: token, chatID: chatID, tpl: tpl}
+}
+
+func (n *TelegramNotifier) Notify(ctx context.Context, msg *Message) error {
+	text, err := render(n.tpl, msg)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/bot%s/sendMessage", n.apiUrl, n.token)
+	respBody, err := postJSON(ctx, url, map[string]string{"chat_id": n.chatID, "text": text})
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Ok          bool   `json:"ok"`
+		Description string `json:"description"`
+	}
+	if err = json.Unmarshal(respBody, &resp); err != nil {
+		return errorx.Wrap("failed to parse telegram response", err)
+	}
+	if !resp.Ok {
+		return errorx.New("telegram returns error", "description", resp.Description)
+	}
+	return nil
+}
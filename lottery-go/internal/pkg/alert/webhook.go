@@ -0,0 +1,149 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"text/template"
+	"time"
+
+	"lottery-go/internal/base/errorx"
+)
+
+// 发送报警请求的超时时间
+const httpTimeout = 10 * time.Second
+
+var httpClient = &http.Client{Timeout: httpTimeout}
+
+// postJSON 以 JSON 格式 POST 请求，返回响应体
+func postJSON(ctx context.Context, url string, body interface{}) ([]byte, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, errorx.Wrap("failed to marshal alert body", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, errorx.Wrap("failed to create alert request", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errorx.Wrap("failed to post alert", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, errorx.New("alert request failed", "status", resp.StatusCode, "body", string(respBody))
+	}
+	return respBody, nil
+}
+
+// WebhookNotifier 通用 webhook，POST JSON 格式的报警消息
+type WebhookNotifier struct {
+	url string
+	tpl *template.Template
+}
+
+func NewWebhookNotifier(url string, tpl *template.Template) *WebhookNotifier {
+	return &WebhookNotifier{url: url, tpl: tpl}
+}
+
+type webhookBody struct {
+	Severity      string    `json:"severity"`
+	Title         string    `json:"title"`
+	Job           string    `json:"job"`
+	LotteryNumber uint64    `json:"lotteryNumber"`
+	TryCount      uint8     `json:"tryCount"`
+	Error         string    `json:"error,omitempty"`
+	Time          time.Time `json:"time"`
+	Text          string    `json:"text"` // 按模板渲染后的文本
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, msg *Message) error {
+	text, err := render(n.tpl, msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = postJSON(ctx, n.url, &webhookBody{
+		Severity:      msg.Severity.String(),
+		Title:         msg.Title,
+		Job:           msg.Job,
+		LotteryNumber: msg.LotteryNumber,
+		TryCount:      msg.TryCount,
+		Error:         msg.Error,
+		Time:          msg.Time,
+		Text:          text,
+	})
+	return err
+}
+
+// 机器人类型
+const (
+	RobotSlack    = "slack"
+	RobotDingTalk = "dingtalk"
+	RobotFeishu   = "feishu"
+)
+
+// RobotNotifier Slack、钉钉、飞书等群机器人 webhook，发送文本消息
+type RobotNotifier struct {
+	robot string
+	url   string
+	tpl   *template.Template
+}
+
+func NewRobotNotifier(robot string, url string, tpl *template.Template) (*RobotNotifier, error) {
+	switch robot {
+	case RobotSlack, RobotDingTalk, RobotFeishu:
+		return &RobotNotifier{robot: robot, url: url, tpl: tpl}, nil
+	}
+	return nil, errorx.New("unknown robot type", "type", robot)
+}
+
+func (n *RobotNotifier) Notify(ctx context.Context, msg *Message) error {
+	text, err := render(n.tpl, msg)
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+	switch n.robot {
+	case RobotSlack:
+		body = map[string]interface{}{"text": text}
+	case RobotDingTalk:
+		body = map[string]interface{}{"msgtype": "text", "text": map[string]string{"content": text}}
+	case RobotFeishu:
+		body = map[string]interface{}{"msg_type": "text", "content": map[string]string{"text": text}}
+	}
+
+	respBody, err := postJSON(ctx, n.url, body)
+	if err != nil {
+		return err
+	}
+	return checkRobotResponse(n.robot, respBody)
+}
+
+// checkRobotResponse 钉钉、飞书请求失败时HTTP状态码仍为200，需检查响应中的错误码
+func checkRobotResponse(robot string, body []byte) error {
+	var resp struct {
+		ErrCode int    `json:"errcode"` // 钉钉
+		ErrMsg  string `json:"errmsg"`
+		Code    int    `json:"code"` // 飞书
+		Msg     string `json:"msg"`
+	}
+	if robot == RobotSlack || len(body) == 0 || json.Unmarshal(body, &resp) != nil {
+		return nil
+	}
+
+	if resp.ErrCode != 0 || resp.Code != 0 {
+		return fmt.Errorf("%s robot returns error. errcode=%d, code=%d, msg=%s%s",
+			robot, resp.ErrCode, resp.Code, resp.ErrMsg, resp.Msg)
+	}
+	return nil
+}
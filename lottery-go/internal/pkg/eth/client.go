@@ -3,132 +3,684 @@ package eth
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"lottery-go/internal/base/errorx"
-	"strings"
+	"lottery-go/internal/base/logx"
+	"lottery-go/internal/pkg/metrics"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
 )
 
+// CallContext 按ABI和函数名调用view函数，用于临时调用；合约层优先使用 bindings 生成的类型安全绑定
 type CallContext struct {
-	RpcUrl   string
 	Address  string
 	Abi      string
 	FuncName string
 }
 
 type TransactionContext struct {
-	RpcUrl     string
-	Address    string
-	Abi        string
-	FuncName   string
-	PrivateKey string
+	Address        string
+	Abi            string
+	FuncName       string
+	Signer         Signer        // 交易签名者
+	GasPricer      GasPricer     // 为nil时使用默认策略的 FeeHistoryPricer
+	GasLimitMargin float64       // gasLimit 在估算值基础上增加的比例，为0时使用默认值
+	Replace        ReplacePolicy // 交易长时间未上链时的加速策略
+	Simulate       bool          // 发送前在pending区块上模拟执行，会revert时不发送交易
+	ErrorAbi       string        // 合约自定义错误的ABI，用于解析模拟执行的revert原因
+	Data           []byte        // 已编码的调用数据（如 bindings 的 PackXxx），设置后忽略 Abi 和 args
+	Value          *big.Int      // 交易附带的ETH（wei），用于payable函数，为nil时不附带
 }
 
-// CallContractView 通用的合约view函数调用方法
-func CallContractView(ctx *CallContext, result interface{}, args ...interface{}) error {
-	// 连接节点
-	client, err := ethclient.Dial(ctx.RpcUrl)
-	if err != nil {
-		return errorx.Wrap("failed to connect Ethereum rpc client", err)
+// Timeouts RPC调用超时时间，为0时使用默认值
+type Timeouts struct {
+	Call    time.Duration // 单次RPC调用超时，超时后切换到下一个节点
+	Send    time.Duration // 发送交易超时（签名、估算gas、广播）
+	Confirm time.Duration // 等待交易确认超时
+}
+
+const (
+	defaultCallTimeout    = 10 * time.Second
+	defaultSendTimeout    = 30 * time.Second
+	defaultConfirmTimeout = 5 * time.Minute
+
+	// 查询交易回执的间隔
+	receiptPollInterval = time.Second
+)
+
+func (t Timeouts) withDefaults() Timeouts {
+	if t.Call <= 0 {
+		t.Call = defaultCallTimeout
+	}
+	if t.Send <= 0 {
+		t.Send = defaultSendTimeout
 	}
-	defer client.Close()
+	if t.Confirm <= 0 {
+		t.Confirm = defaultConfirmTimeout
+	}
+	return t
+}
 
-	// 目标合约地址
-	contractAddr := common.HexToAddress(ctx.Address)
+// Options 客户端配置
+type Options struct {
+	Endpoints   []Endpoint
+	Timeouts    Timeouts
+	HealthCheck HealthCheck
+	Journal     TxJournal // 交易日志，为nil时使用内存存储
+	// Confirmations 交易所在区块之后的确认数（含所在区块），达到后再核对区块是否被回滚；为0时使用1，不做核对
+	Confirmations uint64
+}
 
-	// 解析 ABI
-	parsedABI, err := abi.JSON(strings.NewReader(ctx.Abi))
+// Client 长连接的以太坊 RPC 客户端，支持 HTTP 和 WebSocket。
+// 同一个 Client 在多个合约间共享，缓存已解析的 ABI；配置多个节点时按健康度自动切换。
+type Client struct {
+	endpoints   []*endpoint
+	timeouts    Timeouts
+	healthCheck HealthCheck
+
+	mu      sync.Mutex
+	chainID *big.Int
+
+	abis sync.Map // abi json -> *abi.ABI
+
+	nonces  *nonceManager
+	pending sync.Map // pendingKey -> *pendingTx，等待确认的交易
+	journal TxJournal
+
+	confirmations uint64
+
+	stop context.CancelFunc
+}
+
+func NewClient(opts Options) (*Client, error) {
+	if len(opts.Endpoints) == 0 {
+		return nil, errorx.New("rpc endpoints are empty")
+	}
+
+	endpoints := make([]*endpoint, 0, len(opts.Endpoints))
+	for _, conf := range opts.Endpoints {
+		ep, err := newEndpoint(conf)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, ep)
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	c := &Client{
+		endpoints:     endpoints,
+		timeouts:      opts.Timeouts.withDefaults(),
+		healthCheck:   opts.HealthCheck.withDefaults(),
+		journal:       opts.Journal,
+		confirmations: opts.Confirmations,
+		stop:          stop,
+	}
+	if c.journal == nil {
+		c.journal = NewMemoryTxJournal()
+	}
+	c.nonces = newNonceManager(c.pendingNonce)
+
+	// 只有一个节点时无需切换，不做健康检查
+	if len(endpoints) > 1 {
+		go c.healthLoop(ctx)
+	}
+	return c, nil
+}
+
+// Close 停止健康检查并关闭所有连接
+func (c *Client) Close() {
+	c.stop()
+	for _, ep := range c.endpoints {
+		ep.close()
+	}
+}
+
+// ABI 解析并缓存合约 ABI
+func (c *Client) ABI(abiJSON string) (*abi.ABI, error) {
+	if parsed, ok := c.abis.Load(abiJSON); ok {
+		return parsed.(*abi.ABI), nil
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
 	if err != nil {
-		return errorx.Wrap("failed to parse contract ABI", err)
+		return nil, errorx.Wrap("failed to parse contract ABI", err)
 	}
 
-	// 获取函数调用数据
-	data, err := parsedABI.Pack(ctx.FuncName, args...)
+	actual, _ := c.abis.LoadOrStore(abiJSON, &parsed)
+	return actual.(*abi.ABI), nil
+}
+
+// rpcOp 描述一次RPC调用，用于监控指标
+type rpcOp struct {
+	kind     string // call、send、broadcast、receipt、chainId、balance、blockNumber、code、nonce
+	function string // 合约函数名，非合约调用时为空
+}
+
+// withEndpoint 按节点排序依次执行 fn，直到成功或遇到不可重试的错误（如合约revert）。
+// 每个节点的连接断开时会重连重试一次。fn 需保证可重复执行。
+func (c *Client) withEndpoint(ctx context.Context, op rpcOp, timeout time.Duration, fn func(ctx context.Context, client *ethclient.Client) error) error {
+	var lastErr error
+	for _, ep := range c.ranked() {
+		for retry := 0; retry < 2; retry++ {
+			if ctx.Err() != nil {
+				if lastErr == nil {
+					lastErr = ctx.Err()
+				}
+				return lastErr
+			}
+
+			client, err := ep.conn(ctx)
+			if err != nil {
+				ep.record(0, err)
+				op.observe(ep, 0, metrics.ResultError)
+				lastErr = err
+				break
+			}
+
+			attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+			start := time.Now()
+			err = fn(attemptCtx, client)
+			cancel()
+			latency := time.Since(start)
+
+			if err == nil || !isFailoverError(err) {
+				// 合约revert等错误说明节点本身正常
+				ep.record(latency, nil)
+				op.observe(ep, latency, resultOf(err))
+				return err
+			}
+
+			ep.record(latency, err)
+			op.observe(ep, latency, metrics.ResultError)
+			lastErr = err
+			logx.Warn("rpc call failed.", "endpoint", ep.name, "err", err)
+
+			if !isConnectionError(err) {
+				break
+			}
+			ep.reset(client)
+		}
+	}
+	return lastErr
+}
+
+// observe 记录单个节点的调用次数和耗时，连接失败时不记录耗时
+func (op rpcOp) observe(ep *endpoint, latency time.Duration, result string) {
+	metrics.RpcRequests.WithLabelValues(op.kind, op.function, ep.name, result).Inc()
+	if latency > 0 {
+		metrics.RpcDuration.WithLabelValues(op.kind, op.function, ep.name).Observe(latency.Seconds())
+	}
+}
+
+// resultOf 节点正常返回时的调用结果，交易未找到视为正常
+func resultOf(err error) string {
+	if err == nil || errors.Is(err, ethereum.NotFound) {
+		return metrics.ResultOk
+	}
+	return metrics.ResultRevert
+}
+
+// ChainID 获取链ID，首次获取后缓存
+func (c *Client) ChainID(ctx context.Context) (*big.Int, error) {
+	c.mu.Lock()
+	chainID := c.chainID
+	c.mu.Unlock()
+	if chainID != nil {
+		return chainID, nil
+	}
+
+	err := c.withEndpoint(ctx, rpcOp{kind: "chainId"}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		chainID, err = client.ChainID(ctx)
+		return err
+	})
 	if err != nil {
-		return errorx.Wrap("failed to pack function call", err, "function", ctx.FuncName)
+		return nil, errorx.Wrap("failed to get chain ID", err)
 	}
 
-	// 发送 call
+	c.mu.Lock()
+	c.chainID = chainID
+	c.mu.Unlock()
+	return chainID, nil
+}
+
+// Call 使用已编码的调用数据在最新区块上执行view调用，返回未解码的结果，funcName 用于日志和指标
+func (c *Client) Call(ctx context.Context, contractAddr common.Address, funcName string, data []byte) ([]byte, error) {
 	msg := ethereum.CallMsg{
 		To:   &contractAddr,
 		Data: data,
 	}
 
-	res, err := client.CallContract(context.Background(), msg, nil)
+	var res []byte
+	err := c.withEndpoint(ctx, rpcOp{kind: "call", function: funcName}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		res, err = client.CallContract(ctx, msg, nil)
+		return err
+	})
+	if err != nil {
+		return nil, errorx.Wrap("failed to call function", err, "function", funcName)
+	}
+	return res, nil
+}
+
+// CallContractView 通用的合约view函数调用方法
+func (c *Client) CallContractView(ctx context.Context, call *CallContext, result interface{}, args ...interface{}) error {
+	// 目标合约地址
+	contractAddr := common.HexToAddress(call.Address)
+
+	// 解析 ABI
+	parsedABI, err := c.ABI(call.Abi)
+	if err != nil {
+		return err
+	}
+
+	// 获取函数调用数据
+	data, err := parsedABI.Pack(call.FuncName, args...)
 	if err != nil {
-		return errorx.Wrap("failed to call function", err, "function", ctx.FuncName)
+		return errorx.Wrap("failed to pack function call", err, "function", call.FuncName)
+	}
+
+	res, err := c.Call(ctx, contractAddr, call.FuncName, data)
+	if err != nil {
+		return err
 	}
 
 	// 解析返回结果
-	err = parsedABI.UnpackIntoInterface(result, ctx.FuncName, res)
+	err = parsedABI.UnpackIntoInterface(result, call.FuncName, res)
 	if err != nil {
-		return errorx.Wrap("failed to unpack result", err, "function", ctx.FuncName)
+		return errorx.Wrap("failed to unpack result", err, "function", call.FuncName)
 	}
 
 	return nil
 }
 
-// SendTransaction 通用的合约交易发送方法
-func SendTransaction(ctx *TransactionContext, args ...interface{}) (*types.Receipt, error) {
-	// 连接节点
-	client, err := ethclient.Dial(ctx.RpcUrl)
+// SendTransaction 通用的合约交易发送方法，等待交易达到确认数后返回结果。
+// 交易只签名一次，节点切换时广播同一笔交易，避免重复发送。
+// 返回 error 表示交易未发送或结果未知（如等待确认超时），此时交易日志保留，下次发送相同调用时继续等待；
+// 交易已广播时同时返回带 TxHash 的结果。
+func (c *Client) SendTransaction(ctx context.Context, txCtx *TransactionContext, args ...interface{}) (*TxResult, error) {
+	// 目标合约地址
+	contractAddr := common.HexToAddress(txCtx.Address)
+
+	// 签名账户和链ID
+	signer, chainID, err := c.signer(ctx, txCtx)
 	if err != nil {
-		return nil, errorx.Wrap("failed to connect Ethereum rpc client", err)
+		return nil, err
 	}
-	defer client.Close()
+	account := signer.Address()
 
-	// 目标合约地址
-	contractAddr := common.HexToAddress(ctx.Address)
+	// 获取函数调用数据
+	data, argsKey, err := c.callData(txCtx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	pricer := pricerOrDefault(txCtx.GasPricer)
+	sign := func(tx *types.Transaction) (*types.Transaction, error) { return signer.SignTx(ctx, tx, chainID) }
 
-	// 解析私钥
-	privateKey, err := crypto.HexToECDSA(ctx.PrivateKey)
+	// 相同调用的交易仍未确认（如进程崩溃前发送的交易）时继续等待，不重复发送
+	p, err := c.resumePending(ctx, account, contractAddr, txCtx.FuncName, argsKey, sign)
 	if err != nil {
-		return nil, errorx.Wrap("failed to parse private key", err)
+		return nil, err
 	}
+	if p == nil {
+		if txCtx.Simulate {
+			if err = c.simulate(ctx, account, contractAddr, data, txCtx); err != nil {
+				return nil, err
+			}
+		}
 
-	// 获取链ID
-	chainID, err := client.NetworkID(context.Background())
+		tx, err := c.signAndBroadcast(ctx, signer, chainID, contractAddr, data, txCtx, pricer, argsKey)
+		if err != nil {
+			return nil, err
+		}
+		p = &pendingTx{account: account, nonce: tx.Nonce(), funcName: txCtx.FuncName, args: argsKey,
+			txs: []*types.Transaction{tx}, sign: sign}
+	}
+
+	// 记录等待确认的交易，供加速、取消时使用
+	key := pendingKey{account: account, nonce: p.nonce}
+	c.pending.Store(key, p)
+	defer c.pending.Delete(key)
+
+	// 等待交易上链，超时后交易可能仍在内存池中，也可能已被丢弃，下次发送前重新同步nonce
+	receipt, err := c.waitMined(ctx, p, txCtx.Replace.withDefaults(), pricer)
 	if err != nil {
-		return nil, errorx.Wrap("failed to get network ID", err)
+		c.nonces.reset(account)
+		return c.notMined(ctx, p, err)
 	}
 
-	// 创建认证
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	// 等待确认数，区块被回滚时保留交易日志，交易可能重新进入内存池
+	outcome, confirmed, err := c.waitConfirmed(ctx, p, receipt)
 	if err != nil {
-		return nil, errorx.Wrap("failed to create transactor", err)
+		return &TxResult{TxHash: receipt.TxHash}, errorx.Wrap("failed to wait for confirmations", err, "txHash", receipt.TxHash.Hex())
+	}
+	result := &TxResult{Outcome: outcome, TxHash: receipt.TxHash, Receipt: confirmed}
+	if outcome == Reorged {
+		c.nonces.reset(account)
+	} else {
+		c.journalDone(p)
+		observeReceipt(txCtx.FuncName, confirmed)
+	}
+
+	metrics.TxOutcomes.WithLabelValues(txCtx.FuncName, outcome.String()).Inc()
+	logx.Info("transaction finished.", "function", txCtx.FuncName, "txHash", result.TxHash.Hex(), "outcome", outcome)
+	return result, nil
+}
+
+// callData 交易的调用数据，以及交易日志中用于识别相同调用的参数
+func (c *Client) callData(txCtx *TransactionContext, args []interface{}) ([]byte, string, error) {
+	if txCtx.Data != nil {
+		key := hexutil.Encode(txCtx.Data)
+		if txCtx.Value != nil {
+			key += "@" + txCtx.Value.String()
+		}
+		return txCtx.Data, key, nil
 	}
 
 	// 解析 ABI
-	parsedABI, err := abi.JSON(strings.NewReader(ctx.Abi))
+	parsedABI, err := c.ABI(txCtx.Abi)
 	if err != nil {
-		return nil, errorx.Wrap("failed to parse contract ABI", err)
+		return nil, "", err
+	}
+
+	data, err := parsedABI.Pack(txCtx.FuncName, args...)
+	if err != nil {
+		return nil, "", errorx.Wrap("failed to pack function call", err, "function", txCtx.FuncName)
+	}
+	return data, fmt.Sprintf("%v", args), nil
+}
+
+// notMined 交易未上链：已被取消、替换或被节点丢弃时返回 Dropped；
+// 仍可能在内存池中（等待超时、应用停止）时返回 error，保留交易日志
+func (c *Client) notMined(ctx context.Context, p *pendingTx, err error) (*TxResult, error) {
+	latest, _ := p.latest()
+	dropped := errors.Is(err, ErrTxCancelled) || errors.Is(err, ErrTxReplaced)
+	if !dropped && errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		var checkErr error
+		if dropped, checkErr = c.isDropped(ctx, p); checkErr != nil {
+			logx.Warn("failed to check transaction.", "txHash", latest.Hash().Hex(), "err", checkErr)
+		}
+	}
+	if !dropped {
+		return &TxResult{TxHash: latest.Hash()}, errorx.Wrap("failed to wait for transaction confirmation", err, "txHash", latest.Hash().Hex())
+	}
+
+	c.journalDone(p)
+	metrics.TxOutcomes.WithLabelValues(p.funcName, Dropped.String()).Inc()
+	logx.Warn("transaction dropped.", "function", p.funcName, "txHash", latest.Hash().Hex(), "err", err)
+	return &TxResult{Outcome: Dropped, TxHash: latest.Hash()}, nil
+}
+
+// simulate 在pending区块上执行与交易相同的调用，会revert时返回解析后的 *ContractError
+func (c *Client) simulate(ctx context.Context, from, to common.Address, data []byte, txCtx *TransactionContext) error {
+	err := c.withEndpoint(ctx, rpcOp{kind: "simulate", function: txCtx.FuncName}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		_, err := client.PendingCallContract(ctx, ethereum.CallMsg{From: from, To: &to, Value: txCtx.Value, Data: data})
+		return err
+	})
+	if err == nil {
+		return nil
 	}
 
-	// 创建合约实例
-	contractInstance := bind.NewBoundContract(contractAddr, parsedABI, client, client, client)
+	err = errorx.Wrap("transaction simulation failed", err, "function", txCtx.FuncName)
+	if contractErr := ParseContractError(txCtx.ErrorAbi, err); contractErr != nil {
+		logx.Info("transaction simulation reverted, skip sending.", "function", txCtx.FuncName, "error", contractErr.Type)
+		return contractErr
+	}
+	return err
+}
+
+// signAndBroadcast 分配nonce、估算gas、定价并签名交易，写入交易日志后广播
+func (c *Client) signAndBroadcast(ctx context.Context, signer Signer, chainID *big.Int, contractAddr common.Address,
+	data []byte, txCtx *TransactionContext, pricer GasPricer, args string) (*types.Transaction, error) {
+	margin := txCtx.GasLimitMargin
+	if margin <= 0 {
+		margin = defaultGasLimitMargin
+	}
+
+	// 同一账户串行发送，使用本地维护的nonce
+	var gasPrice *GasPrice
+	tx, err := c.nonces.send(ctx, signer.Address(), func(nonce uint64) (*types.Transaction, error) {
+		// 估算gas、定价并签名交易，此时尚未广播，可切换节点重试
+		var tx *types.Transaction
+		err := c.withEndpoint(ctx, rpcOp{kind: "send", function: txCtx.FuncName}, c.timeouts.Send, func(ctx context.Context, client *ethclient.Client) error {
+			gasLimit, err := client.EstimateGas(ctx, ethereum.CallMsg{From: signer.Address(), To: &contractAddr,
+				Value: txCtx.Value, Data: data})
+			if err != nil {
+				return err
+			}
+
+			if gasPrice, err = pricer.Price(ctx, client); err != nil {
+				return err
+			}
 
-	// 发送交易
-	tx, err := contractInstance.Transact(auth, ctx.FuncName, args...)
+			tx, err = signer.SignTx(ctx, newTransaction(chainID, nonce, &contractAddr, txCtx.Value, data,
+				gasLimitWithMargin(gasLimit, margin), gasPrice), chainID)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// 广播前写入交易日志，进程崩溃后可恢复
+		if err = c.journalPut(signer.Address(), tx, txCtx.FuncName, args); err != nil {
+			return nil, err
+		}
+
+		// 广播交易
+		if err = c.broadcast(ctx, txCtx.FuncName, tx); err != nil {
+			c.journalRejected(tx, err)
+			return tx, err
+		}
+		return tx, nil
+	})
 	if err != nil {
-		return nil, errorx.Wrap("failed to send transaction", err, "function", ctx.FuncName)
+		return nil, errorx.Wrap("failed to send transaction", err, "function", txCtx.FuncName)
+	}
+	logx.Info("transaction sent.", "function", txCtx.FuncName, "txHash", tx.Hash().Hex(), "nonce", tx.Nonce(),
+		"gasLimit", tx.Gas(), "gasPrice", FormatGwei(gasPrice.GasPrice), "maxFee", FormatGwei(gasPrice.GasFeeCap),
+		"maxTip", FormatGwei(gasPrice.GasTipCap))
+	return tx, nil
+}
+
+// signer 交易的签名者和链ID
+func (c *Client) signer(ctx context.Context, txCtx *TransactionContext) (Signer, *big.Int, error) {
+	if txCtx.Signer == nil {
+		return nil, nil, errorx.New("signer is not configured", "function", txCtx.FuncName)
 	}
 
-	// 等待交易确认
-	receipt, err := bind.WaitMined(context.Background(), client, tx)
+	// 获取链ID
+	chainID, err := c.ChainID(ctx)
 	if err != nil {
-		return nil, errorx.Wrap("failed to wait for transaction confirmation", err)
+		return nil, nil, err
+	}
+	return txCtx.Signer, chainID, nil
+}
+
+func pricerOrDefault(pricer GasPricer) GasPricer {
+	if pricer == nil {
+		return NewFeeHistoryPricer(GasPolicy{})
+	}
+	return pricer
+}
+
+// newTransaction 按定价类型创建未签名的交易，value 为nil时不附带ETH
+func newTransaction(chainID *big.Int, nonce uint64, to *common.Address, value *big.Int, data []byte, gasLimit uint64, price *GasPrice) *types.Transaction {
+	if price.IsLegacy() {
+		return types.NewTx(&types.LegacyTx{Nonce: nonce, GasPrice: price.GasPrice, Gas: gasLimit, To: to, Value: value, Data: data})
+	}
+	return types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: nonce, GasTipCap: price.GasTipCap,
+		GasFeeCap: price.GasFeeCap, Gas: gasLimit, To: to, Value: value, Data: data})
+}
+
+// broadcast 广播已签名的交易，节点已收到同一笔交易时视为成功
+func (c *Client) broadcast(ctx context.Context, funcName string, tx *types.Transaction) error {
+	return c.withEndpoint(ctx, rpcOp{kind: "broadcast", function: funcName}, c.timeouts.Send, func(ctx context.Context, client *ethclient.Client) error {
+		err := client.SendTransaction(ctx, tx)
+		if err != nil && strings.Contains(strings.ToLower(err.Error()), "already known") {
+			return nil
+		}
+		return err
+	})
+}
+
+// BalanceAt 查询账户在最新区块的余额（wei）
+func (c *Client) BalanceAt(ctx context.Context, address common.Address) (*big.Int, error) {
+	var balance *big.Int
+	err := c.withEndpoint(ctx, rpcOp{kind: "balance"}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		balance, err = client.BalanceAt(ctx, address, nil)
+		return err
+	})
+	if err != nil {
+		return nil, errorx.Wrap("failed to get balance", err, "address", address.Hex())
+	}
+	return balance, nil
+}
+
+// pendingNonce 获取账户包含内存池交易的下一个nonce
+func (c *Client) pendingNonce(ctx context.Context, account common.Address) (uint64, error) {
+	var nonce uint64
+	err := c.withEndpoint(ctx, rpcOp{kind: "nonce"}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		nonce, err = client.PendingNonceAt(ctx, account)
+		return err
+	})
+	if err != nil {
+		return 0, errorx.Wrap("failed to get nonce", err, "account", account.Hex())
+	}
+	return nonce, nil
+}
+
+// BlockNumber 获取最新区块高度
+func (c *Client) BlockNumber(ctx context.Context) (uint64, error) {
+	var blockNumber uint64
+	err := c.withEndpoint(ctx, rpcOp{kind: "blockNumber"}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		blockNumber, err = client.BlockNumber(ctx)
+		return err
+	})
+	if err != nil {
+		return 0, errorx.Wrap("failed to get block number", err)
+	}
+	return blockNumber, nil
+}
+
+// CodeAt 获取地址在最新区块的合约代码，普通账户返回空
+func (c *Client) CodeAt(ctx context.Context, address common.Address) ([]byte, error) {
+	var code []byte
+	err := c.withEndpoint(ctx, rpcOp{kind: "code"}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		code, err = client.CodeAt(ctx, address, nil)
+		return err
+	})
+	if err != nil {
+		return nil, errorx.Wrap("failed to get code", err, "address", address.Hex())
+	}
+	return code, nil
+}
+
+// FilterLogs 查询区块范围内的合约事件，结果按区块和日志序号排序；funcName 用于日志和指标
+func (c *Client) FilterLogs(ctx context.Context, funcName string, query ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	err := c.withEndpoint(ctx, rpcOp{kind: "logs", function: funcName}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		logs, err = client.FilterLogs(ctx, query)
+		return err
+	})
+	if err != nil {
+		return nil, errorx.Wrap("failed to filter logs", err, "function", funcName, "from", query.FromBlock, "to", query.ToBlock)
+	}
+	return logs, nil
+}
+
+// observeReceipt 记录交易消耗的gas和手续费
+func observeReceipt(funcName string, receipt *types.Receipt) {
+	metrics.TxGasUsed.WithLabelValues(funcName).Observe(float64(receipt.GasUsed))
+	if receipt.EffectiveGasPrice == nil {
+		return
+	}
+
+	gasPrice, _ := new(big.Float).Quo(new(big.Float).SetInt(receipt.EffectiveGasPrice), big.NewFloat(params.GWei)).Float64()
+	metrics.TxGasPrice.WithLabelValues(funcName).Observe(gasPrice)
+
+	fee := new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+	metrics.TxFeePaid.WithLabelValues(funcName).Add(WeiToEther(fee))
+}
+
+// WeiToEther wei 转换为 ether，仅用于展示和监控
+func WeiToEther(wei *big.Int) float64 {
+	ether, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether)).Float64()
+	return ether
+}
+
+// isFailoverError 判断是否需要切换节点重试：合约revert、交易未找到等业务错误不切换
+func isFailoverError(err error) bool {
+	if errors.Is(err, ethereum.NotFound) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		return false
+	}
+
+	errStr := strings.ToLower(err.Error())
+	if isRevertError(errStr) {
+		return false
+	}
+
+	// 节点正常返回的JSON-RPC错误（如nonce过低、余额不足）换节点也无法解决，
+	// 但区块未同步、限流等节点自身的问题需要切换
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return strings.Contains(errStr, "header not found") || isRateLimitError(rpcErr.ErrorCode(), errStr)
+	}
+	return true
+}
+
+// rateLimitCode 节点限流时返回的JSON-RPC错误码（EIP-1474 limit exceeded）
+const rateLimitCode = -32005
+
+// isRateLimitError 节点是否因限流拒绝请求。只匹配明确的限流错误码和提示，
+// "exceeds block gas limit" 等节点对交易本身的拒绝换节点也无法解决
+func isRateLimitError(code int, errStr string) bool {
+	return code == rateLimitCode || code == http.StatusTooManyRequests ||
+		strings.Contains(errStr, "rate limit") ||
+		strings.Contains(errStr, "too many requests")
+}
+
+// isConnectionError 判断是否为连接层面的错误（需要重连）
+func isConnectionError(err error) bool {
+	if errors.Is(err, rpc.ErrClientQuit) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
 	}
 
-	// 检查交易状态
-	if receipt.Status != 1 {
-		return nil, errorx.New("transaction failed")
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
 	}
 
-	return receipt, nil
+	// websocket 断开时返回的错误没有导出类型
+	errStr := err.Error()
+	return strings.Contains(errStr, "use of closed network connection") ||
+		strings.Contains(errStr, "websocket: close")
 }
@@ -0,0 +1,257 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"lottery-go/internal/base/lifecycle"
+	"lottery-go/internal/pkg/metrics"
+)
+
+// rpcError handler 返回该类型时响应JSON-RPC错误
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// newRpcServer 本地JSON-RPC节点，handler 返回 result，返回 nil 时响应 HTTP 500
+func newRpcServer(t *testing.T, handler func(method string) interface{}) (*httptest.Server, *int32) {
+	return newChainServer(t, func(method string, _ []json.RawMessage) interface{} { return handler(method) })
+}
+
+// newChainServer 本地JSON-RPC节点，handler 可根据请求参数返回 result，返回 nil 时响应 HTTP 500
+func newChainServer(t *testing.T, handler func(method string, params []json.RawMessage) interface{}) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		result := handler(req.Method, req.Params)
+		if result == nil {
+			http.Error(w, "unavailable", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if rpcErr, ok := result.(*rpcError); ok {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "error": rpcErr})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestClient_Failover(t *testing.T) {
+	down, downCalls := newRpcServer(t, func(string) interface{} { return nil })
+	up, upCalls := newRpcServer(t, func(string) interface{} { return "0xaa36a7" })
+
+	client, err := NewClient(Options{Endpoints: []Endpoint{
+		{Url: down.URL, Priority: 1},
+		{Url: up.URL, Priority: 2},
+	}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	defer client.Close()
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		t.Fatalf("fails to ChainID(), %v", err)
+	}
+	if chainID.Int64() != 11155111 {
+		t.Fatalf("unexpected chain id: %v", chainID)
+	}
+	if atomic.LoadInt32(downCalls) == 0 || atomic.LoadInt32(upCalls) == 0 {
+		t.Fatalf("expected both endpoints called, down=%d up=%d", *downCalls, *upCalls)
+	}
+
+	// 按节点记录调用结果
+	upName := client.endpoints[1].name
+	if count := testutil.ToFloat64(metrics.RpcRequests.WithLabelValues("chainId", "", upName, metrics.ResultOk)); count != 1 {
+		t.Fatalf("unexpected ok count for %s: %v", upName, count)
+	}
+	downName := client.endpoints[0].name
+	if count := testutil.ToFloat64(metrics.RpcRequests.WithLabelValues("chainId", "", downName, metrics.ResultError)); count == 0 {
+		t.Fatalf("expected error count for %s", downName)
+	}
+}
+
+// jsonRpcError 节点返回的JSON-RPC错误
+type jsonRpcError struct {
+	code    int
+	message string
+}
+
+func (e *jsonRpcError) Error() string  { return e.message }
+func (e *jsonRpcError) ErrorCode() int { return e.code }
+
+func TestIsFailoverError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		failover bool
+	}{
+		{name: "http 500", err: rpc.HTTPError{StatusCode: http.StatusInternalServerError}, failover: true},
+		{name: "http 429", err: rpc.HTTPError{StatusCode: http.StatusTooManyRequests}, failover: true},
+		{name: "header not found", err: &jsonRpcError{code: -32000, message: "header not found"}, failover: true},
+		{name: "limit exceeded code", err: &jsonRpcError{code: -32005, message: "request exceeds defined limit"}, failover: true},
+		{name: "429 code", err: &jsonRpcError{code: 429, message: "exceeded"}, failover: true},
+		{name: "rate limit", err: &jsonRpcError{code: -32000, message: "Rate limit exceeded"}, failover: true},
+		{name: "too many requests", err: &jsonRpcError{code: -32000, message: "Too Many Requests"}, failover: true},
+		{name: "nonce too low", err: &jsonRpcError{code: -32000, message: "nonce too low"}, failover: false},
+		{name: "block gas limit", err: &jsonRpcError{code: -32000, message: "exceeds block gas limit"}, failover: false},
+		{name: "gas limit reached", err: &jsonRpcError{code: -32000, message: "gas limit reached"}, failover: false},
+		{name: "generate", err: &jsonRpcError{code: -32000, message: "failed to generate trace"}, failover: false},
+		{name: "accurate", err: &jsonRpcError{code: -32000, message: "gas estimate is not accurate"}, failover: false},
+		{name: "canceled", err: context.Canceled, failover: false},
+	}
+	for _, tt := range tests {
+		if failover := isFailoverError(tt.err); failover != tt.failover {
+			t.Fatalf("%s: expected failover %v, got %v", tt.name, tt.failover, failover)
+		}
+	}
+}
+
+func TestClient_RankUnhealthyLast(t *testing.T) {
+	client, err := NewClient(Options{Endpoints: []Endpoint{
+		{Url: "http://primary:8545", Priority: 1},
+		{Url: "http://backup:8545", Priority: 2},
+	}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	defer client.Close()
+
+	// 主节点连续失败后排到备用节点之后
+	for i := 0; i < 5; i++ {
+		client.endpoints[0].record(0, context.DeadlineExceeded)
+	}
+
+	if ranked := client.ranked(); ranked[0].name != "http://backup:8545" {
+		t.Fatalf("expected backup endpoint first, got %s", ranked[0].name)
+	}
+}
+
+// newSendServer 可发送交易的本地节点，交易广播后调用 sent，回执查询一直返回未找到
+func newSendServer(t *testing.T, sent func()) *httptest.Server {
+	server, _ := newChainServer(t, func(method string, _ []json.RawMessage) interface{} {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_getTransactionCount":
+			return "0x0"
+		case "eth_estimateGas":
+			return "0x5208"
+		case "eth_blockNumber":
+			return "0x10"
+		case "eth_sendRawTransaction":
+			sent()
+			return common.Hash{}.Hex()
+		case "eth_getTransactionReceipt", "eth_getTransactionByHash":
+			return &rpcError{Code: -32000, Message: "not found"}
+		}
+		return nil
+	})
+	return server
+}
+
+func newTestTxContext(t *testing.T) *TransactionContext {
+	signer, err := NewHexKeySigner("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &TransactionContext{Address: "0x0000000000000000000000000000000000000002", FuncName: "drawLottery",
+		Data: []byte{1, 2, 3, 4}, Signer: signer,
+		GasPricer: fixedPricer{price: &GasPrice{GasTipCap: gwei(1), GasFeeCap: gwei(2)}}}
+}
+
+func TestClient_CallTimeout(t *testing.T) {
+	// 节点无响应，测试结束时（先于关闭节点）释放
+	hang := make(chan struct{})
+	server, _ := newRpcServer(t, func(string) interface{} {
+		<-hang
+		return nil
+	})
+	t.Cleanup(func() { close(hang) })
+
+	client, err := NewClient(Options{Endpoints: []Endpoint{{Url: server.URL}}, Timeouts: Timeouts{Call: 100 * time.Millisecond}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	defer client.Close()
+
+	start := time.Now()
+	_, err = client.Call(context.Background(), common.HexToAddress("0x02"), "lotteryNumber", []byte{1})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("call not bounded by Timeouts.Call, took %s", elapsed)
+	}
+}
+
+func TestClient_ConfirmTimeout(t *testing.T) {
+	server := newSendServer(t, func() {})
+	client, err := NewClient(Options{Endpoints: []Endpoint{{Url: server.URL}}, Timeouts: Timeouts{Confirm: 300 * time.Millisecond}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	defer client.Close()
+
+	// 交易一直未上链时，等待确认在 Timeouts.Confirm 后返回，不阻塞定时任务
+	start := time.Now()
+	result, err := client.SendTransaction(context.Background(), newTestTxContext(t))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	// 交易已广播，返回交易哈希供调用方记录
+	if result == nil || result.TxHash == (common.Hash{}) {
+		t.Fatalf("expected broadcast tx hash, got %+v", result)
+	}
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Fatalf("confirmation wait not bounded by Timeouts.Confirm, took %s", elapsed)
+	}
+}
+
+func TestClient_SendCanceledOnShutdown(t *testing.T) {
+	lc, cancel := lifecycle.New()
+	defer cancel()
+
+	// 交易广播后停止应用
+	server := newSendServer(t, func() { go lc.Cancel() })
+	client, err := NewClient(Options{Endpoints: []Endpoint{{Url: server.URL}}, Timeouts: Timeouts{Confirm: time.Minute}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	defer client.Close()
+
+	start := time.Now()
+	_, err = client.SendTransaction(lc.Context(), newTestTxContext(t))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Fatalf("send not aborted on shutdown, took %s", elapsed)
+	}
+}
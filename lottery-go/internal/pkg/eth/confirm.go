@@ -0,0 +1,178 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/base/logx"
+)
+
+// TxOutcome 交易的最终结果
+type TxOutcome uint8
+
+const (
+	Confirmed TxOutcome = iota + 1 // 执行成功，且达到确认数
+	Reorged                        // 上链后所在区块被回滚，交易可能重新进入内存池
+	Reverted                       // 执行失败，且达到确认数
+	Dropped                        // 未上链：被节点丢弃，或nonce已被其他交易（如取消交易）使用
+)
+
+func (outcome TxOutcome) String() string {
+	switch outcome {
+	case Confirmed:
+		return "Confirmed"
+	case Reorged:
+		return "Reorged"
+	case Reverted:
+		return "Reverted"
+	case Dropped:
+		return "Dropped"
+	}
+	return "Unknown"
+}
+
+// TxResult 交易结果，Receipt 仅在 Confirmed、Reverted 时有值。
+// 交易已广播但等待上链或确认失败（如应用停止）时，SendTransaction 同时返回error和只有 TxHash 的结果
+type TxResult struct {
+	Outcome TxOutcome
+	TxHash  common.Hash
+	Receipt *types.Receipt
+}
+
+// 轮询区块高度的间隔，测试中可缩短
+var blockPollInterval = 3 * time.Second
+
+// waitConfirmed 等待回执所在区块之后达到确认数，再核对回执所在区块仍在主链上。
+// 交易被重新打包到其他区块时，按新的区块重新等待。
+// 回执查询不到时（负载均衡后的节点落后也会返回未找到），回执所在区块仍在主链上则继续等待，不视为回滚。
+func (c *Client) waitConfirmed(ctx context.Context, p *pendingTx, receipt *types.Receipt) (TxOutcome, *types.Receipt, error) {
+	if c.confirmations <= 1 {
+		return outcomeOf(receipt), receipt, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeouts.Confirm)
+	defer cancel()
+
+	ticker := time.NewTicker(blockPollInterval)
+	defer ticker.Stop()
+
+	for {
+		target := receipt.BlockNumber.Uint64() + c.confirmations - 1
+		if head, err := c.BlockNumber(ctx); err == nil && head >= target {
+			current, err := c.canonicalReceipt(ctx, p.funcName, receipt.TxHash)
+			if err != nil {
+				return 0, nil, err
+			}
+			if current == nil {
+				canonical, err := c.isCanonical(ctx, p.funcName, receipt)
+				if err != nil {
+					return 0, nil, err
+				}
+				if canonical {
+					logx.Warn("transaction receipt not found, block still canonical.", "function", p.funcName,
+						"txHash", receipt.TxHash.Hex(), "blockNumber", receipt.BlockNumber)
+					if err = waitTick(ctx, ticker); err != nil {
+						return 0, nil, err
+					}
+					continue
+				}
+				logx.Warn("transaction reorged.", "function", p.funcName, "txHash", receipt.TxHash.Hex(),
+					"blockNumber", receipt.BlockNumber, "blockHash", receipt.BlockHash.Hex())
+				return Reorged, nil, nil
+			}
+			if current.BlockHash == receipt.BlockHash {
+				return outcomeOf(current), current, nil
+			}
+
+			logx.Warn("transaction re-included in another block.", "function", p.funcName, "txHash", receipt.TxHash.Hex(),
+				"blockNumber", current.BlockNumber, "blockHash", current.BlockHash.Hex())
+			receipt = current
+			continue
+		}
+
+		if err := waitTick(ctx, ticker); err != nil {
+			return 0, nil, err
+		}
+	}
+}
+
+// waitTick 等待下一次轮询，ctx 结束时返回其错误
+func waitTick(ctx context.Context, ticker *time.Ticker) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ticker.C:
+		return nil
+	}
+}
+
+// isCanonical 回执所在区块是否仍在主链上；节点没有该区块（落后）时不能判断，按仍在主链上处理
+func (c *Client) isCanonical(ctx context.Context, funcName string, receipt *types.Receipt) (bool, error) {
+	var header *types.Header
+	err := c.withEndpoint(ctx, rpcOp{kind: "header", function: funcName}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		header, err = client.HeaderByNumber(ctx, receipt.BlockNumber)
+		return err
+	})
+	if errors.Is(err, ethereum.NotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, errorx.Wrap("failed to verify transaction block", err, "txHash", receipt.TxHash.Hex())
+	}
+	return header.Hash() == receipt.BlockHash, nil
+}
+
+// canonicalReceipt 重新查询回执，并核对回执所在区块是否仍在主链上；交易不在主链上时返回nil
+func (c *Client) canonicalReceipt(ctx context.Context, funcName string, txHash common.Hash) (*types.Receipt, error) {
+	var receipt *types.Receipt
+	var header *types.Header
+	err := c.withEndpoint(ctx, rpcOp{kind: "receipt", function: funcName}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		if receipt, err = client.TransactionReceipt(ctx, txHash); err != nil {
+			return err
+		}
+		header, err = client.HeaderByNumber(ctx, receipt.BlockNumber)
+		return err
+	})
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorx.Wrap("failed to verify transaction receipt", err, "txHash", txHash.Hex())
+	}
+	if header.Hash() != receipt.BlockHash {
+		return nil, nil
+	}
+	return receipt, nil
+}
+
+// isDropped 同一nonce发送的交易节点都已不再持有
+func (c *Client) isDropped(ctx context.Context, p *pendingTx) (bool, error) {
+	for _, tx := range p.list() {
+		err := c.withEndpoint(ctx, rpcOp{kind: "transaction", function: p.funcName}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+			_, _, err := client.TransactionByHash(ctx, tx.Hash())
+			return err
+		})
+		if err == nil {
+			return false, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func outcomeOf(receipt *types.Receipt) TxOutcome {
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return Confirmed
+	}
+	return Reverted
+}
@@ -0,0 +1,116 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// newReorgServer 交易打包在第10个区块，reorged 为 true 时该区块已被替换
+func newReorgServer(t *testing.T, txHash common.Hash, reorged *atomic.Bool) (*Client, *types.Receipt) {
+	header := &types.Header{Number: big.NewInt(10), Difficulty: common.Big0}
+	forked := &types.Header{Number: big.NewInt(10), Difficulty: common.Big0, Extra: []byte("fork")}
+
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: txHash, BlockHash: header.Hash(),
+		BlockNumber: big.NewInt(10), Logs: []*types.Log{}}
+
+	server, _ := newChainServer(t, func(method string, _ []json.RawMessage) interface{} {
+		switch method {
+		case "eth_blockNumber":
+			return hexutil.Uint64(12)
+		case "eth_getTransactionReceipt":
+			return receipt
+		case "eth_getBlockByNumber":
+			if reorged.Load() {
+				return forked
+			}
+			return header
+		}
+		return nil
+	})
+
+	client, err := NewClient(Options{Endpoints: []Endpoint{{Url: server.URL}}, Confirmations: 3})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	t.Cleanup(client.Close)
+	return client, receipt
+}
+
+func TestWaitConfirmed(t *testing.T) {
+	txHash := common.HexToHash("0x01")
+	var reorged atomic.Bool
+	client, receipt := newReorgServer(t, txHash, &reorged)
+	p := &pendingTx{funcName: "drawLottery"}
+
+	outcome, confirmed, err := client.waitConfirmed(context.Background(), p, receipt)
+	if err != nil {
+		t.Fatalf("fails to waitConfirmed(), %v", err)
+	}
+	if outcome != Confirmed || confirmed.TxHash != txHash {
+		t.Fatalf("unexpected outcome: %s", outcome)
+	}
+
+	// 回执所在区块已不在主链上
+	reorged.Store(true)
+	outcome, confirmed, err = client.waitConfirmed(context.Background(), p, receipt)
+	if err != nil {
+		t.Fatalf("fails to waitConfirmed(), %v", err)
+	}
+	if outcome != Reorged || confirmed != nil {
+		t.Fatalf("expected Reorged, got %s", outcome)
+	}
+}
+
+func TestWaitConfirmed_ReceiptMissingOnLaggingNode(t *testing.T) {
+	interval := blockPollInterval
+	blockPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { blockPollInterval = interval })
+
+	header := &types.Header{Number: big.NewInt(10), Difficulty: common.Big0}
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: common.HexToHash("0x01"),
+		BlockHash: header.Hash(), BlockNumber: big.NewInt(10), Logs: []*types.Log{}}
+
+	// 前两次查询回执时节点落后返回未找到，区块仍在主链上
+	var misses atomic.Int32
+	server, _ := newChainServer(t, func(method string, _ []json.RawMessage) interface{} {
+		switch method {
+		case "eth_blockNumber":
+			return hexutil.Uint64(12)
+		case "eth_getTransactionReceipt":
+			if misses.Add(1) <= 2 {
+				return json.RawMessage("null")
+			}
+			return receipt
+		case "eth_getBlockByNumber":
+			return header
+		}
+		return nil
+	})
+	client, err := NewClient(Options{Endpoints: []Endpoint{{Url: server.URL}}, Confirmations: 3})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	defer client.Close()
+
+	outcome, confirmed, err := client.waitConfirmed(context.Background(), &pendingTx{funcName: "drawLottery"}, receipt)
+	if err != nil {
+		t.Fatalf("fails to waitConfirmed(), %v", err)
+	}
+	if outcome != Confirmed || confirmed == nil {
+		t.Fatalf("expected Confirmed, got %s", outcome)
+	}
+}
+
+func TestOutcomeOf(t *testing.T) {
+	if outcome := outcomeOf(&types.Receipt{Status: types.ReceiptStatusFailed}); outcome != Reverted {
+		t.Fatalf("expected Reverted, got %s", outcome)
+	}
+}
@@ -0,0 +1,270 @@
+package eth
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/base/logx"
+)
+
+// Endpoint RPC节点配置，Priority 越小优先级越高
+type Endpoint struct {
+	Url      string
+	Priority int
+}
+
+// HealthCheck 节点健康检查配置，为0时使用默认值
+type HealthCheck struct {
+	Interval     time.Duration // 检查间隔
+	MaxBlockLag  uint64        // 与最高区块相差超过该值视为不健康
+	MaxErrorRate float64       // 错误率超过该值视为不健康，取值 (0, 1]
+}
+
+const (
+	defaultHealthCheckInterval = 30 * time.Second
+	defaultMaxBlockLag         = 5
+	defaultMaxErrorRate        = 0.5
+
+	// 延迟与错误率的指数加权系数
+	ewmaAlpha = 0.3
+)
+
+func (hc HealthCheck) withDefaults() HealthCheck {
+	if hc.Interval <= 0 {
+		hc.Interval = defaultHealthCheckInterval
+	}
+	if hc.MaxBlockLag == 0 {
+		hc.MaxBlockLag = defaultMaxBlockLag
+	}
+	if hc.MaxErrorRate <= 0 || hc.MaxErrorRate > 1 {
+		hc.MaxErrorRate = defaultMaxErrorRate
+	}
+	return hc
+}
+
+// endpoint 单个RPC节点，维护连接和健康度统计
+type endpoint struct {
+	url      string
+	name     string // 用于日志，去掉路径避免泄露 api key
+	priority int
+
+	mu          sync.Mutex
+	client      *ethclient.Client
+	latency     time.Duration // 调用延迟（EWMA）
+	errorRate   float64       // 调用错误率（EWMA）
+	blockNumber uint64        // 最近一次健康检查获取的区块高度
+	blockLag    uint64        // 与所有节点中最高区块的差值
+}
+
+func newEndpoint(conf Endpoint) (*endpoint, error) {
+	u, err := url.Parse(conf.Url)
+	if err != nil {
+		return nil, errorx.Wrap("invalid rpc url", err)
+	}
+	if u.Host == "" {
+		return nil, errorx.New("invalid rpc url", "url", u.Redacted())
+	}
+	return &endpoint{url: conf.Url, name: u.Scheme + "://" + u.Host, priority: conf.Priority}, nil
+}
+
+// conn 获取当前连接，未连接时建立连接
+func (ep *endpoint) conn(ctx context.Context) (*ethclient.Client, error) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+
+	if ep.client == nil {
+		client, err := ethclient.DialContext(ctx, ep.url)
+		if err != nil {
+			return nil, errorx.Wrap("failed to connect Ethereum rpc client", err, "endpoint", ep.name)
+		}
+		ep.client = client
+	}
+	return ep.client, nil
+}
+
+// reset 丢弃已断开的连接，下次调用时重新建立
+func (ep *endpoint) reset(broken *ethclient.Client) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+
+	// 其他协程可能已经完成重连
+	if ep.client == broken {
+		ep.client.Close()
+		ep.client = nil
+		logx.Warn("rpc connection reset.", "endpoint", ep.name)
+	}
+}
+
+func (ep *endpoint) close() {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+
+	if ep.client != nil {
+		ep.client.Close()
+		ep.client = nil
+	}
+}
+
+// record 记录一次调用结果，更新延迟和错误率
+func (ep *endpoint) record(latency time.Duration, err error) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+
+	failed := 0.0
+	if err != nil {
+		failed = 1
+	} else if ep.latency == 0 {
+		ep.latency = latency
+	} else {
+		ep.latency = time.Duration(ewmaAlpha*float64(latency) + (1-ewmaAlpha)*float64(ep.latency))
+	}
+	ep.errorRate = ewmaAlpha*failed + (1-ewmaAlpha)*ep.errorRate
+}
+
+func (ep *endpoint) setBlockLag(head uint64) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+
+	if head > ep.blockNumber {
+		ep.blockLag = head - ep.blockNumber
+	} else {
+		ep.blockLag = 0
+	}
+}
+
+// EndpointStatus 节点健康状态
+type EndpointStatus struct {
+	Name        string
+	Priority    int
+	Healthy     bool
+	Latency     time.Duration
+	ErrorRate   float64
+	BlockNumber uint64
+	BlockLag    uint64
+}
+
+func (ep *endpoint) status(hc HealthCheck) EndpointStatus {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+
+	return EndpointStatus{
+		Name:        ep.name,
+		Priority:    ep.priority,
+		Healthy:     ep.errorRate <= hc.MaxErrorRate && ep.blockLag <= hc.MaxBlockLag,
+		Latency:     ep.latency,
+		ErrorRate:   ep.errorRate,
+		BlockNumber: ep.blockNumber,
+		BlockLag:    ep.blockLag,
+	}
+}
+
+// score 节点得分，越小越好：延迟按错误率放大，并叠加区块落后的惩罚
+func (s EndpointStatus) score() float64 {
+	return float64(s.Latency.Milliseconds()+1)*(1+10*s.ErrorRate) + float64(s.BlockLag)*100
+}
+
+// ranked 按健康状态、优先级、得分排序后的节点列表
+func (c *Client) ranked() []*endpoint {
+	type rankedEndpoint struct {
+		ep     *endpoint
+		status EndpointStatus
+	}
+
+	list := make([]rankedEndpoint, 0, len(c.endpoints))
+	for _, ep := range c.endpoints {
+		list = append(list, rankedEndpoint{ep: ep, status: ep.status(c.healthCheck)})
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i].status, list[j].status
+		if a.Healthy != b.Healthy {
+			return a.Healthy
+		}
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.score() < b.score()
+	})
+
+	endpoints := make([]*endpoint, 0, len(list))
+	for _, item := range list {
+		endpoints = append(endpoints, item.ep)
+	}
+	return endpoints
+}
+
+// Endpoints 所有节点的健康状态，按当前使用顺序排列
+func (c *Client) Endpoints() []EndpointStatus {
+	statuses := make([]EndpointStatus, 0, len(c.endpoints))
+	for _, ep := range c.ranked() {
+		statuses = append(statuses, ep.status(c.healthCheck))
+	}
+	return statuses
+}
+
+// healthLoop 定期检查各节点的区块高度和延迟
+func (c *Client) healthLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.healthCheck.Interval)
+	defer ticker.Stop()
+
+	for {
+		c.checkHealth(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) checkHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, ep := range c.endpoints {
+		wg.Add(1)
+		go func(ep *endpoint) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, c.timeouts.Call)
+			defer cancel()
+
+			client, err := ep.conn(checkCtx)
+			if err != nil {
+				ep.record(0, err)
+				return
+			}
+
+			start := time.Now()
+			blockNumber, err := client.BlockNumber(checkCtx)
+			ep.record(time.Since(start), err)
+			if err != nil {
+				if isConnectionError(err) {
+					ep.reset(client)
+				}
+				logx.Warn("rpc health check failed.", "endpoint", ep.name, "err", err)
+				return
+			}
+
+			ep.mu.Lock()
+			ep.blockNumber = blockNumber
+			ep.mu.Unlock()
+		}(ep)
+	}
+	wg.Wait()
+
+	// 计算各节点落后于最高区块的高度
+	var head uint64
+	for _, ep := range c.endpoints {
+		if status := ep.status(c.healthCheck); status.BlockNumber > head {
+			head = status.BlockNumber
+		}
+	}
+	for _, ep := range c.endpoints {
+		ep.setBlockLag(head)
+	}
+}
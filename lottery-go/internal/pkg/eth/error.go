@@ -3,65 +3,121 @@ package eth
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
-// ContractError 自定义错误类型
+// ContractError 合约revert错误，包含解码后的错误名称和参数
 type ContractError struct {
-	Type    string
-	Message string
+	Type      string                 // 自定义错误名称，标准错误为 Error、Panic，无法解析时为 Unknown
+	Message   string                 // 可读的错误描述
+	Args      map[string]interface{} // 自定义错误的参数，按参数名索引，未命名参数为 arg0、arg1...
+	Reason    string                 // Error(string) 的原因
+	PanicCode *big.Int               // Panic(uint256) 的错误码
+	Data      []byte                 // 原始revert数据
+	cause     error
 }
 
 // 常量定义
 const (
 	// 最小选择器长度（4字节）
 	minSelectorLength = 4
-	// 最小十六进制字符串长度（0x + 8个字符 = 10个字符）
-	minHexStringLength = 10
 	// 错误类型
 	unknownErrorType = "Unknown"
+	errorStringType  = "Error"
+	panicType        = "Panic"
 )
 
+var (
+	// Error(string) 和 Panic(uint256) 的选择器
+	errorStringSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	panicSelector       = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+	stringType, _  = abi.NewType("string", "", nil)
+)
+
+// panicReasons Solidity panic 错误码的含义
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assert failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
 // Error 实现error接口
 func (e *ContractError) Error() string {
 	return e.Message
 }
 
-// ParseContractError 解析合约返回的错误信息
+// Unwrap 返回原始的RPC错误
+func (e *ContractError) Unwrap() error {
+	return e.cause
+}
+
+// Arg 按参数名获取自定义错误的参数
+func (e *ContractError) Arg(name string) (interface{}, bool) {
+	value, ok := e.Args[name]
+	return value, ok
+}
+
+// ParseContractError 解析合约返回的错误信息，沿 errors.Unwrap 链查找revert数据，
+// 非revert错误返回nil
 func ParseContractError(errorAbi string, err error) *ContractError {
 	if err == nil {
 		return nil
 	}
 
+	var contractErr *ContractError
+	if errors.As(err, &contractErr) {
+		return contractErr
+	}
+
 	errStr := err.Error()
-	if !isRevertError(errStr) {
+	revertData := extractRevertData(err)
+	if revertData == nil && !isRevertError(errStr) {
 		return nil
 	}
+	if len(revertData) < minSelectorLength {
+		return newUnknownContractError(errStr, revertData, err)
+	}
+
+	selector, payload := revertData[:minSelectorLength], revertData[minSelectorLength:]
+	switch {
+	case bytes.Equal(selector, errorStringSelector):
+		if decoded := decodeErrorString(payload, revertData, err); decoded != nil {
+			return decoded
+		}
+	case bytes.Equal(selector, panicSelector):
+		if decoded := decodePanic(payload, revertData, err); decoded != nil {
+			return decoded
+		}
+	}
 
 	// 解析错误ABI
 	errorABI, parseErr := abi.JSON(strings.NewReader(errorAbi))
 	if parseErr != nil {
-		return newUnknownContractError(errStr)
-	}
-
-	// 尝试获取revert数据
-	revertData := extractFromWrappedError(err)
-	if len(revertData) < minSelectorLength {
-		return newUnknownContractError(errStr)
+		return newUnknownContractError(errStr, revertData, err)
 	}
 
-	// 匹配错误类型
-	selector := revertData[:4]
-	if errorName := matchErrorType(selector, errorABI.Errors); errorName != "" {
-		return &ContractError{
-			Type:    errorName,
-			Message: errStr,
+	if errorDef := matchErrorType(selector, errorABI.Errors); errorDef != nil {
+		if decoded := decodeCustomError(errorDef, payload, revertData, err); decoded != nil {
+			return decoded
 		}
 	}
 
-	return newUnknownContractError(errStr)
+	return newUnknownContractError(errStr, revertData, err)
 }
 
 // isRevertError 检查是否为revert错误
@@ -71,61 +127,113 @@ func isRevertError(errStr string) bool {
 }
 
 // newUnknownContractError 创建未知错误
-func newUnknownContractError(message string) *ContractError {
+func newUnknownContractError(message string, data []byte, cause error) *ContractError {
 	return &ContractError{
 		Type:    unknownErrorType,
 		Message: message,
+		Data:    data,
+		cause:   cause,
 	}
 }
 
-// extractFromWrappedError 从包装错误中提取数据
-func extractFromWrappedError(err error) []byte {
-	if wrapErr, ok := err.(interface{ Unwrap() error }); ok {
-		wrappedErr := wrapErr.Unwrap()
-		if rpcErr, ok := wrappedErr.(interface{ ErrorData() interface{} }); ok {
-			// 尝试转换为字符串
-			if str, ok := rpcErr.ErrorData().(string); ok && str != "" {
-				return parseHexString(str)
-			}
-		}
+// decodeErrorString 解码 require(cond, "reason") 产生的 Error(string)
+func decodeErrorString(payload, data []byte, cause error) *ContractError {
+	values, err := abi.Arguments{{Type: stringType}}.Unpack(payload)
+	if err != nil || len(values) != 1 {
+		return nil
+	}
+
+	reason := values[0].(string)
+	return &ContractError{
+		Type:    errorStringType,
+		Message: "execution reverted: " + reason,
+		Reason:  reason,
+		Data:    data,
+		cause:   cause,
 	}
-	return nil
 }
 
-// matchErrorType 匹配错误类型
-func matchErrorType(selector []byte, errors map[string]abi.Error) string {
-	for errorName, errorDef := range errors {
-		// 检查选择器是否匹配（前4字节）
-		if len(selector) >= minSelectorLength {
-			if bytes.Equal(selector, errorDef.ID[:minSelectorLength]) {
-				return errorName
-			}
-		}
+// decodePanic 解码 assert、溢出等产生的 Panic(uint256)
+func decodePanic(payload, data []byte, cause error) *ContractError {
+	values, err := abi.Arguments{{Type: uint256Type}}.Unpack(payload)
+	if err != nil || len(values) != 1 {
+		return nil
+	}
 
-		// 检查完整的十六进制字符串匹配
-		if strings.EqualFold(hex.EncodeToString(selector), hex.EncodeToString(errorDef.ID[:])) {
-			return errorName
-		}
+	code := values[0].(*big.Int)
+	description, ok := panicReasons[code.Uint64()]
+	if !ok || !code.IsUint64() {
+		description = "unknown panic"
+	}
+	return &ContractError{
+		Type:      panicType,
+		Message:   fmt.Sprintf("panic: %s (0x%x)", description, code),
+		PanicCode: code,
+		Data:      data,
+		cause:     cause,
 	}
-	return ""
 }
 
-// parseHexString 解析十六进制字符串
-func parseHexString(str string) []byte {
-	// 尝试解析带0x前缀的字符串
-	if strings.HasPrefix(str, "0x") {
-		data, err := hex.DecodeString(str[2:])
-		if err == nil && len(data) >= minSelectorLength {
-			return data
+// decodeCustomError 按ABI解码自定义错误的参数，消息格式为 Name(arg=value, ...)
+func decodeCustomError(errorDef *abi.Error, payload, data []byte, cause error) *ContractError {
+	values, err := errorDef.Inputs.Unpack(payload)
+	if err != nil {
+		return nil
+	}
+
+	args := make(map[string]interface{}, len(values))
+	pairs := make([]string, 0, len(values))
+	for i, value := range values {
+		name := errorDef.Inputs[i].Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
 		}
+		args[name] = value
+		pairs = append(pairs, fmt.Sprintf("%s=%v", name, value))
+	}
+
+	return &ContractError{
+		Type:    errorDef.Name,
+		Message: fmt.Sprintf("%s(%s)", errorDef.Name, strings.Join(pairs, ", ")),
+		Args:    args,
+		Data:    data,
+		cause:   cause,
+	}
+}
+
+// extractRevertData 沿 errors.Unwrap 链查找携带revert数据的RPC错误
+func extractRevertData(err error) []byte {
+	var dataErr interface{ ErrorData() interface{} }
+	if !errors.As(err, &dataErr) {
 		return nil
 	}
 
-	// 尝试解析不带0x前缀的字符串
-	data, err := hex.DecodeString(str)
-	if err == nil && len(data) >= minSelectorLength {
+	switch data := dataErr.ErrorData().(type) {
+	case string:
+		return parseHexString(data)
+	case []byte:
+		return data
+	case hexutil.Bytes:
 		return data
 	}
+	return nil
+}
 
+// matchErrorType 按选择器匹配错误定义
+func matchErrorType(selector []byte, errors map[string]abi.Error) *abi.Error {
+	for _, errorDef := range errors {
+		if bytes.Equal(selector, errorDef.ID[:minSelectorLength]) {
+			return &errorDef
+		}
+	}
 	return nil
 }
+
+// parseHexString 解析十六进制字符串，带或不带0x前缀
+func parseHexString(str string) []byte {
+	data, err := hex.DecodeString(strings.TrimPrefix(str, "0x"))
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,87 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// dataError 模拟携带revert数据的RPC错误
+type dataError struct {
+	data string
+}
+
+func (e *dataError) Error() string          { return "execution reverted" }
+func (e *dataError) ErrorData() interface{} { return e.data }
+
+func revertData(t *testing.T, selector []byte, args abi.Arguments, values ...interface{}) string {
+	t.Helper()
+	payload, err := args.Pack(values...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hexutil.Encode(append(append([]byte{}, selector...), payload...))
+}
+
+func TestParseContractError(t *testing.T) {
+	errorABI, err := abi.JSON(strings.NewReader(testErrorABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom := errorABI.Errors["MinDrawIntervalNotMet"]
+
+	tests := []struct {
+		name    string
+		data    string
+		errType string
+		message string
+	}{
+		{"errorString", revertData(t, errorStringSelector, abi.Arguments{{Type: stringType}}, "not owner"),
+			"Error", "execution reverted: not owner"},
+		{"panic", revertData(t, panicSelector, abi.Arguments{{Type: uint256Type}}, big.NewInt(0x11)),
+			"Panic", "panic: arithmetic overflow or underflow (0x11)"},
+		{"custom", revertData(t, custom.ID[:4], custom.Inputs, big.NewInt(100), big.NewInt(50)),
+			"MinDrawIntervalNotMet", "MinDrawIntervalNotMet(startTime=100, currentTime=50)"},
+		{"unknownSelector", "0xdeadbeef", "Unknown", "execution reverted"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// 多层包装
+			err := fmt.Errorf("send: %w", fmt.Errorf("call: %w", &dataError{data: test.data}))
+
+			contractErr := ParseContractError(testErrorABI, err)
+			if contractErr == nil {
+				t.Fatal("expected contract error")
+			}
+			if contractErr.Type != test.errType || !strings.HasSuffix(contractErr.Message, test.message) {
+				t.Fatalf("got %s %q, want %s %q", contractErr.Type, contractErr.Message, test.errType, test.message)
+			}
+			if errors.Unwrap(contractErr) != err {
+				t.Fatal("contract error should unwrap to the rpc error")
+			}
+		})
+	}
+}
+
+func TestParseContractError_Args(t *testing.T) {
+	errorABI, _ := abi.JSON(strings.NewReader(testErrorABI))
+	custom := errorABI.Errors["MinDrawIntervalNotMet"]
+	err := fmt.Errorf("wrapped: %w", &dataError{data: revertData(t, custom.ID[:4], custom.Inputs, big.NewInt(100), big.NewInt(50))})
+
+	contractErr := ParseContractError(testErrorABI, err)
+	startTime, ok := contractErr.Arg("startTime")
+	if !ok || startTime.(*big.Int).Int64() != 100 {
+		t.Fatalf("unexpected startTime %v", startTime)
+	}
+}
+
+func TestParseContractError_NotRevert(t *testing.T) {
+	if contractErr := ParseContractError(testErrorABI, errors.New("connection refused")); contractErr != nil {
+		t.Fatalf("expected nil, got %v", contractErr)
+	}
+}
@@ -0,0 +1,181 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"lottery-go/internal/base/errorx"
+)
+
+// GasPolicy 交易的gas定价策略，为0时使用默认值
+type GasPolicy struct {
+	Legacy            bool    // 强制使用legacy交易，链不支持EIP-1559时自动使用
+	FeeHistoryBlocks  uint64  // 统计小费的最近区块数
+	TipPercentile     float64 // 取区块内小费的百分位，取值 (0, 100]
+	MinTipGwei        float64 // 最低小费
+	BaseFeeMultiplier float64 // maxFee = baseFee * multiplier + tip，应对后续区块baseFee上涨
+	MaxFeeGwei        float64 // maxFee（legacy交易为gasPrice）的绝对上限，为0时不限制
+	GasLimitMargin    float64 // gasLimit 在估算值基础上增加的比例
+}
+
+const (
+	defaultFeeHistoryBlocks  = 10
+	defaultTipPercentile     = 50
+	defaultBaseFeeMultiplier = 2
+	defaultGasLimitMargin    = 0.2
+)
+
+func (p GasPolicy) withDefaults() GasPolicy {
+	if p.FeeHistoryBlocks == 0 {
+		p.FeeHistoryBlocks = defaultFeeHistoryBlocks
+	}
+	if p.TipPercentile <= 0 || p.TipPercentile > 100 {
+		p.TipPercentile = defaultTipPercentile
+	}
+	if p.BaseFeeMultiplier < 1 {
+		p.BaseFeeMultiplier = defaultBaseFeeMultiplier
+	}
+	if p.GasLimitMargin <= 0 {
+		p.GasLimitMargin = defaultGasLimitMargin
+	}
+	return p
+}
+
+// GasPrice 交易的gas价格，legacy交易只设置 GasPrice
+type GasPrice struct {
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+// IsLegacy 是否为legacy交易
+func (p *GasPrice) IsLegacy() bool {
+	return p.GasPrice != nil
+}
+
+// GasBackend 定价所需的节点接口，*ethclient.Client 已实现
+type GasBackend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+}
+
+// GasPricer gas定价策略，可按合约替换
+type GasPricer interface {
+	Price(ctx context.Context, backend GasBackend) (*GasPrice, error)
+}
+
+// FeeHistoryPricer 根据最近区块的小费百分位和当前baseFee定价
+type FeeHistoryPricer struct {
+	policy GasPolicy
+}
+
+func NewFeeHistoryPricer(policy GasPolicy) *FeeHistoryPricer {
+	return &FeeHistoryPricer{policy: policy.withDefaults()}
+}
+
+func (p *FeeHistoryPricer) Price(ctx context.Context, backend GasBackend) (*GasPrice, error) {
+	maxFee := gweiToWei(p.policy.MaxFeeGwei)
+
+	if !p.policy.Legacy {
+		head, err := backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		if head.BaseFee != nil {
+			return p.dynamicPrice(ctx, backend, maxFee)
+		}
+	}
+
+	// 链不支持EIP-1559
+	gasPrice, err := backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if maxFee.Sign() > 0 && gasPrice.Cmp(maxFee) > 0 {
+		return nil, errorx.New("gas price exceeds max fee cap", "gasPrice", FormatGwei(gasPrice), "maxFee", FormatGwei(maxFee))
+	}
+	return &GasPrice{GasPrice: gasPrice}, nil
+}
+
+func (p *FeeHistoryPricer) dynamicPrice(ctx context.Context, backend GasBackend, maxFee *big.Int) (*GasPrice, error) {
+	history, err := backend.FeeHistory(ctx, p.policy.FeeHistoryBlocks, nil, []float64{p.policy.TipPercentile})
+	if err != nil {
+		return nil, err
+	}
+	if len(history.BaseFee) == 0 {
+		return nil, errorx.New("empty fee history")
+	}
+	// 最后一个元素为下一个区块的baseFee
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	tip := medianReward(history.Reward)
+	if tip == nil {
+		if tip, err = backend.SuggestGasTipCap(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if minTip := gweiToWei(p.policy.MinTipGwei); tip.Cmp(minTip) < 0 {
+		tip = minTip
+	}
+
+	feeCap := new(big.Int).Add(mulFloat(baseFee, p.policy.BaseFeeMultiplier), tip)
+	if maxFee.Sign() > 0 {
+		// baseFee 超过上限时交易无法上链，直接报错等待下次执行
+		if baseFee.Cmp(maxFee) >= 0 {
+			return nil, errorx.New("base fee exceeds max fee cap", "baseFee", FormatGwei(baseFee), "maxFee", FormatGwei(maxFee))
+		}
+		if feeCap.Cmp(maxFee) > 0 {
+			feeCap = maxFee
+		}
+		// 保证 baseFee + tip 不超过上限
+		if limit := new(big.Int).Sub(feeCap, baseFee); tip.Cmp(limit) > 0 {
+			tip = limit
+		}
+	}
+	return &GasPrice{GasTipCap: tip, GasFeeCap: feeCap}, nil
+}
+
+// medianReward 各区块小费百分位的中位数，没有数据时返回nil
+func medianReward(rewards [][]*big.Int) *big.Int {
+	values := make([]*big.Int, 0, len(rewards))
+	for _, reward := range rewards {
+		if len(reward) > 0 && reward[0] != nil {
+			values = append(values, reward[0])
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+	return new(big.Int).Set(values[len(values)/2])
+}
+
+// gasLimitWithMargin 估算的gasLimit增加安全余量
+func gasLimitWithMargin(estimated uint64, margin float64) uint64 {
+	return estimated + uint64(float64(estimated)*margin)
+}
+
+func gweiToWei(gwei float64) *big.Int {
+	wei, _ := new(big.Float).Mul(big.NewFloat(gwei), big.NewFloat(params.GWei)).Int(nil)
+	return wei
+}
+
+func mulFloat(value *big.Int, multiplier float64) *big.Int {
+	result, _ := new(big.Float).Mul(new(big.Float).SetInt(value), big.NewFloat(multiplier)).Int(nil)
+	return result
+}
+
+// FormatGwei wei 转换为 gwei 字符串，用于日志
+func FormatGwei(wei *big.Int) string {
+	if wei == nil {
+		return ""
+	}
+	return new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.GWei)).Text('f', 3)
+}
@@ -0,0 +1,98 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// fakeGasBackend 固定返回的定价数据，baseFee 为nil时模拟不支持EIP-1559的链
+type fakeGasBackend struct {
+	baseFee  *big.Int
+	rewards  []int64
+	gasPrice *big.Int
+}
+
+func (b *fakeGasBackend) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{BaseFee: b.baseFee}, nil
+}
+
+func (b *fakeGasBackend) FeeHistory(context.Context, uint64, *big.Int, []float64) (*ethereum.FeeHistory, error) {
+	history := &ethereum.FeeHistory{BaseFee: []*big.Int{b.baseFee, b.baseFee}}
+	for _, reward := range b.rewards {
+		history.Reward = append(history.Reward, []*big.Int{big.NewInt(reward)})
+	}
+	return history, nil
+}
+
+func (b *fakeGasBackend) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return b.gasPrice, nil
+}
+
+func (b *fakeGasBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(params.GWei), nil
+}
+
+func gwei(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(params.GWei))
+}
+
+func TestFeeHistoryPricer_Dynamic(t *testing.T) {
+	backend := &fakeGasBackend{baseFee: gwei(10), rewards: []int64{3e9, 1e9, 2e9}}
+
+	price, err := NewFeeHistoryPricer(GasPolicy{}).Price(context.Background(), backend)
+	if err != nil {
+		t.Fatalf("fails to Price(), %v", err)
+	}
+	if price.IsLegacy() {
+		t.Fatal("expected dynamic fee price")
+	}
+	// tip 取中位数 2 gwei，maxFee = 10 * 2 + 2
+	if price.GasTipCap.Cmp(gwei(2)) != 0 || price.GasFeeCap.Cmp(gwei(22)) != 0 {
+		t.Fatalf("unexpected price: tip=%s, maxFee=%s", FormatGwei(price.GasTipCap), FormatGwei(price.GasFeeCap))
+	}
+}
+
+func TestFeeHistoryPricer_MaxFeeCap(t *testing.T) {
+	backend := &fakeGasBackend{baseFee: gwei(10), rewards: []int64{5e9}}
+
+	price, err := NewFeeHistoryPricer(GasPolicy{MaxFeeGwei: 12}).Price(context.Background(), backend)
+	if err != nil {
+		t.Fatalf("fails to Price(), %v", err)
+	}
+	if price.GasFeeCap.Cmp(gwei(12)) != 0 || price.GasTipCap.Cmp(gwei(2)) != 0 {
+		t.Fatalf("unexpected price: tip=%s, maxFee=%s", FormatGwei(price.GasTipCap), FormatGwei(price.GasFeeCap))
+	}
+
+	// baseFee 超过上限时不发送交易
+	backend.baseFee = gwei(20)
+	if _, err = NewFeeHistoryPricer(GasPolicy{MaxFeeGwei: 12}).Price(context.Background(), backend); err == nil {
+		t.Fatal("expected max fee cap error")
+	}
+}
+
+func TestFeeHistoryPricer_Legacy(t *testing.T) {
+	backend := &fakeGasBackend{gasPrice: gwei(5)}
+
+	price, err := NewFeeHistoryPricer(GasPolicy{}).Price(context.Background(), backend)
+	if err != nil {
+		t.Fatalf("fails to Price(), %v", err)
+	}
+	if !price.IsLegacy() || price.GasPrice.Cmp(gwei(5)) != 0 {
+		t.Fatalf("unexpected legacy price: %+v", price)
+	}
+
+	if _, err = NewFeeHistoryPricer(GasPolicy{MaxFeeGwei: 4}).Price(context.Background(), backend); err == nil {
+		t.Fatal("expected max fee cap error")
+	}
+}
+
+func TestGasLimitWithMargin(t *testing.T) {
+	if limit := gasLimitWithMargin(100000, 0.2); limit != 120000 {
+		t.Fatalf("unexpected gas limit: %d", limit)
+	}
+}
@@ -0,0 +1,130 @@
+package eth
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"lottery-go/internal/base/errorx"
+)
+
+// JournalEntry 已签名交易的日志，广播前写入，交易结束（上链、被替换）后删除
+type JournalEntry struct {
+	Hash      string        `json:"hash"`
+	Account   string        `json:"account"`
+	Nonce     uint64        `json:"nonce"`
+	To        string        `json:"to"`
+	Function  string        `json:"function"`
+	Args      string        `json:"args"`
+	Raw       hexutil.Bytes `json:"raw"` // 签名后的交易，重启后可直接重新广播
+	GasPrice  string        `json:"gasPrice,omitempty"`
+	MaxFee    string        `json:"maxFee,omitempty"`
+	MaxTip    string        `json:"maxTip,omitempty"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
+
+// TxJournal 交易日志存储，进程崩溃后用于恢复未确认的交易
+type TxJournal interface {
+	// Put 新增或覆盖日志，key 为交易哈希
+	Put(entry *JournalEntry) error
+	// Delete 删除日志，不存在时忽略
+	Delete(hash string) error
+	// List 按账户、nonce、创建时间排序返回所有日志
+	List() ([]*JournalEntry, error)
+	Close() error
+}
+
+func newJournalEntry(tx *types.Transaction, account, function, args string) (*JournalEntry, error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, errorx.Wrap("failed to marshal transaction", err, "txHash", tx.Hash().Hex())
+	}
+
+	entry := &JournalEntry{
+		Hash:      tx.Hash().Hex(),
+		Account:   account,
+		Nonce:     tx.Nonce(),
+		Function:  function,
+		Args:      args,
+		Raw:       raw,
+		CreatedAt: time.Now(),
+	}
+	if tx.To() != nil {
+		entry.To = tx.To().Hex()
+	}
+	if tx.Type() == types.LegacyTxType {
+		entry.GasPrice = FormatGwei(tx.GasPrice())
+	} else {
+		entry.MaxFee = FormatGwei(tx.GasFeeCap())
+		entry.MaxTip = FormatGwei(tx.GasTipCap())
+	}
+	return entry, nil
+}
+
+// Transaction 解析签名后的交易
+func (entry *JournalEntry) Transaction() (*types.Transaction, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(entry.Raw); err != nil {
+		return nil, errorx.Wrap("failed to unmarshal transaction", err, "txHash", entry.Hash)
+	}
+	return tx, nil
+}
+
+func sortEntries(entries []*JournalEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Account != b.Account {
+			return a.Account < b.Account
+		}
+		if a.Nonce != b.Nonce {
+			return a.Nonce < b.Nonce
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+}
+
+// MemoryTxJournal 基于内存的交易日志，进程重启后数据丢失，用于测试
+type MemoryTxJournal struct {
+	mu      sync.RWMutex
+	entries map[string]*JournalEntry
+}
+
+func NewMemoryTxJournal() *MemoryTxJournal {
+	return &MemoryTxJournal{entries: make(map[string]*JournalEntry)}
+}
+
+func (j *MemoryTxJournal) Put(entry *JournalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	copied := *entry
+	j.entries[entry.Hash] = &copied
+	return nil
+}
+
+func (j *MemoryTxJournal) Delete(hash string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	delete(j.entries, hash)
+	return nil
+}
+
+func (j *MemoryTxJournal) List() ([]*JournalEntry, error) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	entries := make([]*JournalEntry, 0, len(j.entries))
+	for _, entry := range j.entries {
+		copied := *entry
+		entries = append(entries, &copied)
+	}
+	sortEntries(entries)
+	return entries, nil
+}
+
+func (j *MemoryTxJournal) Close() error {
+	return nil
+}
@@ -0,0 +1,88 @@
+package eth
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"lottery-go/internal/base/errorx"
+)
+
+var txJournalBucket = []byte("tx_journal")
+
+// BoltTxJournal 基于 BoltDB 的交易日志，key 为交易哈希，value 为 JSON 格式的日志；每次写入都会落盘
+type BoltTxJournal struct {
+	db *bolt.DB
+}
+
+func NewBoltTxJournal(path string) (*BoltTxJournal, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, errorx.Wrap("failed to create tx journal dir", err, "path", path)
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 3 * time.Second})
+	if err != nil {
+		return nil, errorx.Wrap("failed to open tx journal", err, "path", path)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(txJournalBucket)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, errorx.Wrap("failed to create tx journal bucket", err)
+	}
+
+	return &BoltTxJournal{db: db}, nil
+}
+
+func (j *BoltTxJournal) Put(entry *JournalEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return errorx.Wrap("failed to marshal journal entry", err, "txHash", entry.Hash)
+	}
+
+	err = j.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(txJournalBucket).Put([]byte(entry.Hash), data)
+	})
+	if err != nil {
+		return errorx.Wrap("failed to save journal entry", err, "txHash", entry.Hash)
+	}
+	return nil
+}
+
+func (j *BoltTxJournal) Delete(hash string) error {
+	err := j.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(txJournalBucket).Delete([]byte(hash))
+	})
+	if err != nil {
+		return errorx.Wrap("failed to delete journal entry", err, "txHash", hash)
+	}
+	return nil
+}
+
+func (j *BoltTxJournal) List() ([]*JournalEntry, error) {
+	entries := make([]*JournalEntry, 0)
+	err := j.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(txJournalBucket).ForEach(func(k, v []byte) error {
+			entry := &JournalEntry{}
+			if err := json.Unmarshal(v, entry); err != nil {
+				return errorx.Wrap("failed to unmarshal journal entry", err, "txHash", string(k))
+			}
+			entries = append(entries, entry)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortEntries(entries)
+	return entries, nil
+}
+
+func (j *BoltTxJournal) Close() error {
+	return j.db.Close()
+}
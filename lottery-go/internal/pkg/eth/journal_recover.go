@@ -0,0 +1,180 @@
+package eth
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"lottery-go/internal/base/logx"
+)
+
+// journalPut 广播前写入交易日志，写入失败时不发送交易
+func (c *Client) journalPut(account common.Address, tx *types.Transaction, function, args string) error {
+	entry, err := newJournalEntry(tx, account.Hex(), function, args)
+	if err != nil {
+		return err
+	}
+	return c.journal.Put(entry)
+}
+
+// journalRejected 节点拒绝了交易（交易不在内存池中）时删除日志；网络错误时交易可能已广播，保留日志
+func (c *Client) journalRejected(tx *types.Transaction, err error) {
+	if isFailoverError(err) {
+		return
+	}
+	if delErr := c.journal.Delete(tx.Hash().Hex()); delErr != nil {
+		logx.ErrorF("failed to delete journal entry. %v", delErr)
+	}
+}
+
+// journalDone 交易已结束（上链、被替换），删除同一nonce的所有日志
+func (c *Client) journalDone(p *pendingTx) {
+	for _, tx := range p.list() {
+		if err := c.journal.Delete(tx.Hash().Hex()); err != nil {
+			logx.ErrorF("failed to delete journal entry. %v", err)
+		}
+	}
+}
+
+// journalGroup 同一账户、同一nonce的交易日志
+type journalGroup struct {
+	account common.Address
+	nonce   uint64
+	entries []*JournalEntry
+}
+
+// journalGroups 按账户、nonce分组的交易日志
+func (c *Client) journalGroups() ([]*journalGroup, error) {
+	entries, err := c.journal.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*journalGroup
+	for _, entry := range entries {
+		account := common.HexToAddress(entry.Account)
+		if n := len(groups); n == 0 || groups[n-1].account != account || groups[n-1].nonce != entry.Nonce {
+			groups = append(groups, &journalGroup{account: account, nonce: entry.Nonce})
+		}
+		last := groups[len(groups)-1]
+		last.entries = append(last.entries, entry)
+	}
+	return groups, nil
+}
+
+// pending 根据日志恢复等待确认的交易，sign 为nil时不能加速
+func (g *journalGroup) pending(sign func(tx *types.Transaction) (*types.Transaction, error)) (*pendingTx, error) {
+	p := &pendingTx{account: g.account, nonce: g.nonce, sign: sign}
+	for _, entry := range g.entries {
+		tx, err := entry.Transaction()
+		if err != nil {
+			return nil, err
+		}
+		p.add(tx, p.isCancel(tx))
+		if !p.isCancel(tx) {
+			p.funcName, p.args = entry.Function, entry.Args
+		}
+	}
+	return p, nil
+}
+
+// journaled 交易日志中该账户、该nonce最后一次发送的交易，不存在时返回nil
+func (c *Client) journaled(account common.Address, nonce uint64) (*types.Transaction, error) {
+	groups, err := c.journalGroups()
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if group.account != account || group.nonce != nonce {
+			continue
+		}
+		p, err := group.pending(nil)
+		if err != nil {
+			return nil, err
+		}
+		latest, _ := p.latest()
+		return latest, nil
+	}
+	return nil, nil
+}
+
+// matches 日志中是否有对同一合约、同一函数、同一参数的调用
+func (g *journalGroup) matches(to common.Address, function, args string) bool {
+	for _, entry := range g.entries {
+		if common.HexToAddress(entry.To) == to && entry.Function == function && entry.Args == args {
+			return true
+		}
+	}
+	return false
+}
+
+// resumePending 查找相同调用的未确认交易（如进程崩溃前发送的交易），重新广播后继续等待确认，避免重复发送
+func (c *Client) resumePending(ctx context.Context, account, to common.Address, function, args string,
+	sign func(tx *types.Transaction) (*types.Transaction, error)) (*pendingTx, error) {
+	groups, err := c.journalGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		if group.account != account || !group.matches(to, function, args) {
+			continue
+		}
+
+		p, err := group.pending(sign)
+		if err != nil {
+			return nil, err
+		}
+
+		// 交易可能已上链或已被节点丢弃，重新广播失败不影响后续的回执查询
+		latest, _ := p.latest()
+		if err = c.broadcast(ctx, function, latest); err != nil {
+			logx.Warn("failed to rebroadcast journal transaction.", "txHash", latest.Hash().Hex(), "err", err)
+		}
+		logx.Info("resume pending transaction.", "function", function, "nonce", p.nonce, "txHash", latest.Hash().Hex())
+		return p, nil
+	}
+	return nil, nil
+}
+
+// ReconcileJournal 启动时核对交易日志：已上链或nonce已被使用的交易删除日志，仍未确认的交易重新广播。
+// 重新广播的交易在下次发送相同调用时继续等待确认。单笔交易核对失败只记录日志，保留日志下次启动时再核对。
+func (c *Client) ReconcileJournal(ctx context.Context) error {
+	groups, err := c.journalGroups()
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		p, err := group.pending(nil)
+		if err != nil {
+			return err
+		}
+		latest, _ := p.latest()
+
+		if receipt, tx := c.findReceipt(ctx, p); receipt != nil {
+			logx.Info("journal transaction mined.", "function", p.funcName, "nonce", p.nonce, "txHash", tx.Hash().Hex(),
+				"status", receipt.Status, "cancelled", p.isCancel(tx))
+			c.journalDone(p)
+			continue
+		}
+
+		confirmed, err := c.confirmedNonce(ctx, p.account)
+		if err != nil {
+			logx.ErrorF("failed to reconcile journal transaction. %v", err)
+			continue
+		}
+		if confirmed > p.nonce {
+			logx.Warn("journal transaction replaced.", "function", p.funcName, "nonce", p.nonce, "txHash", latest.Hash().Hex())
+			c.journalDone(p)
+			continue
+		}
+
+		if err = c.broadcast(ctx, p.funcName, latest); err != nil {
+			logx.Warn("failed to rebroadcast journal transaction.", "txHash", latest.Hash().Hex(), "err", err)
+			continue
+		}
+		logx.Info("journal transaction rebroadcast.", "function", p.funcName, "nonce", p.nonce, "txHash", latest.Hash().Hex())
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package eth
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func newSignedTx(t *testing.T, nonce uint64, to common.Address, data []byte, tipGwei int64) (*types.Transaction, common.Address) {
+	key, err := crypto.HexToECDSA("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer := types.LatestSignerForChainID(common.Big1)
+	tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{ChainID: common.Big1, Nonce: nonce,
+		GasTipCap: gwei(tipGwei), GasFeeCap: gwei(100), Gas: 50000, To: &to, Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestTxJournal(t *testing.T) {
+	bolt, err := NewBoltTxJournal(filepath.Join(t.TempDir(), "tx.db"))
+	if err != nil {
+		t.Fatalf("fails to NewBoltTxJournal(), %v", err)
+	}
+	defer bolt.Close()
+
+	for name, journal := range map[string]TxJournal{"memory": NewMemoryTxJournal(), "bolt": bolt} {
+		t.Run(name, func(t *testing.T) {
+			contract := common.HexToAddress("0x0000000000000000000000000000000000000002")
+			tx2, account := newSignedTx(t, 2, contract, []byte{1}, 1)
+			tx1, _ := newSignedTx(t, 1, contract, []byte{1}, 1)
+
+			for _, tx := range []*types.Transaction{tx2, tx1} {
+				entry, err := newJournalEntry(tx, account.Hex(), "drawLottery", "[5]")
+				if err != nil {
+					t.Fatalf("fails to newJournalEntry(), %v", err)
+				}
+				if err = journal.Put(entry); err != nil {
+					t.Fatalf("fails to Put(), %v", err)
+				}
+			}
+
+			entries, err := journal.List()
+			if err != nil {
+				t.Fatalf("fails to List(), %v", err)
+			}
+			if len(entries) != 2 || entries[0].Nonce != 1 || entries[1].Nonce != 2 {
+				t.Fatalf("unexpected entries: %+v", entries)
+			}
+
+			// 日志中的交易可直接重新广播
+			decoded, err := entries[0].Transaction()
+			if err != nil {
+				t.Fatalf("fails to Transaction(), %v", err)
+			}
+			if decoded.Hash() != tx1.Hash() {
+				t.Fatalf("unexpected tx hash: %s", decoded.Hash().Hex())
+			}
+
+			if err = journal.Delete(tx1.Hash().Hex()); err != nil {
+				t.Fatalf("fails to Delete(), %v", err)
+			}
+			if entries, _ = journal.List(); len(entries) != 1 {
+				t.Fatalf("expected 1 entry, got %d", len(entries))
+			}
+		})
+	}
+}
+
+func TestJournalGroups(t *testing.T) {
+	client, err := NewClient(Options{Endpoints: []Endpoint{{Url: "http://localhost:8545"}}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	defer client.Close()
+
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	tx, account := newSignedTx(t, 1, contract, []byte{1}, 1)
+	spedUp, _ := newSignedTx(t, 1, contract, []byte{1}, 2)
+	cancel, _ := newSignedTx(t, 1, account, nil, 3)
+	for _, item := range []struct {
+		tx       *types.Transaction
+		function string
+		args     string
+	}{{tx, "drawLottery", "[5]"}, {spedUp, "drawLottery", "[5]"}, {cancel, "cancel", ""}} {
+		if err = client.journalPut(account, item.tx, item.function, item.args); err != nil {
+			t.Fatalf("fails to journalPut(), %v", err)
+		}
+	}
+
+	groups, err := client.journalGroups()
+	if err != nil {
+		t.Fatalf("fails to journalGroups(), %v", err)
+	}
+	if len(groups) != 1 || !groups[0].matches(contract, "drawLottery", "[5]") || groups[0].matches(contract, "drawLottery", "[6]") {
+		t.Fatalf("unexpected groups: %+v", groups)
+	}
+
+	p, err := groups[0].pending(nil)
+	if err != nil {
+		t.Fatalf("fails to pending(), %v", err)
+	}
+	if latest, cancelled := p.latest(); !cancelled || latest.Hash() != cancel.Hash() || len(p.list()) != 3 {
+		t.Fatal("expected cancelled pending tx with 3 transactions")
+	}
+	if p.funcName != "drawLottery" || p.args != "[5]" {
+		t.Fatalf("unexpected function: %s%s", p.funcName, p.args)
+	}
+}
@@ -0,0 +1,103 @@
+package eth
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"lottery-go/internal/base/logx"
+)
+
+// nonceManager 在本地维护每个账户的nonce，同一账户的交易串行签名、广播。
+// 首次发送或出现nonce相关错误时，从链上的pending nonce重新同步。
+type nonceManager struct {
+	pending func(ctx context.Context, account common.Address) (uint64, error)
+
+	mu       sync.Mutex
+	accounts map[common.Address]*accountNonce
+}
+
+type accountNonce struct {
+	mu     sync.Mutex // 串行发送，持有到交易广播完成
+	next   uint64     // 下一笔交易使用的nonce
+	synced bool       // 为 false 时需要从链上同步
+}
+
+func newNonceManager(pending func(ctx context.Context, account common.Address) (uint64, error)) *nonceManager {
+	return &nonceManager{pending: pending, accounts: make(map[common.Address]*accountNonce)}
+}
+
+func (m *nonceManager) account(account common.Address) *accountNonce {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	acct, ok := m.accounts[account]
+	if !ok {
+		acct = &accountNonce{}
+		m.accounts[account] = acct
+	}
+	return acct
+}
+
+// send 使用分配的nonce执行 send，send 返回已签名的交易（未签名时为nil）。
+// 广播成功后nonce加一；nonce冲突时重新同步并重试一次；签名后广播失败时，交易可能已进入内存池，下次发送前重新同步。
+func (m *nonceManager) send(ctx context.Context, account common.Address,
+	send func(nonce uint64) (*types.Transaction, error)) (*types.Transaction, error) {
+	acct := m.account(account)
+	acct.mu.Lock()
+	defer acct.mu.Unlock()
+
+	for retry := 0; ; retry++ {
+		if !acct.synced {
+			nonce, err := m.pending(ctx, account)
+			if err != nil {
+				return nil, err
+			}
+			acct.next, acct.synced = nonce, true
+		}
+
+		tx, err := send(acct.next)
+		if err == nil {
+			acct.next++
+			return tx, nil
+		}
+
+		if isNonceError(err) {
+			acct.synced = false
+			logx.Warn("nonce conflict, resync.", "account", account.Hex(), "nonce", acct.next, "err", err)
+			if retry == 0 {
+				continue
+			}
+		} else if tx != nil {
+			acct.synced = false
+		}
+		return nil, err
+	}
+}
+
+// lock 持有账户的发送锁，期间同一账户不会签名、广播其他交易
+func (m *nonceManager) lock(account common.Address) (unlock func()) {
+	acct := m.account(account)
+	acct.mu.Lock()
+	return acct.mu.Unlock
+}
+
+// reset 丢弃本地nonce，下次发送前从链上同步
+func (m *nonceManager) reset(account common.Address) {
+	acct := m.account(account)
+	acct.mu.Lock()
+	defer acct.mu.Unlock()
+
+	acct.synced = false
+}
+
+// isNonceError 判断是否为nonce与链上状态不一致导致的错误
+func isNonceError(err error) bool {
+	errStr := strings.ToLower(err.Error())
+	return strings.Contains(errStr, "nonce too low") ||
+		strings.Contains(errStr, "nonce too high") ||
+		strings.Contains(errStr, "invalid nonce") ||
+		strings.Contains(errStr, "replacement transaction underpriced")
+}
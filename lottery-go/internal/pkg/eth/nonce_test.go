@@ -0,0 +1,131 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var testAccount = common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+func newTestTx(nonce uint64) *types.Transaction {
+	return types.NewTx(&types.LegacyTx{Nonce: nonce})
+}
+
+func TestNonceManager_Sequential(t *testing.T) {
+	var syncs int
+	m := newNonceManager(func(context.Context, common.Address) (uint64, error) {
+		syncs++
+		return 7, nil
+	})
+
+	for want := uint64(7); want < 10; want++ {
+		tx, err := m.send(context.Background(), testAccount, func(nonce uint64) (*types.Transaction, error) {
+			return newTestTx(nonce), nil
+		})
+		if err != nil {
+			t.Fatalf("fails to send(), %v", err)
+		}
+		if tx.Nonce() != want {
+			t.Fatalf("unexpected nonce: %d, want %d", tx.Nonce(), want)
+		}
+	}
+	if syncs != 1 {
+		t.Fatalf("expected one sync, got %d", syncs)
+	}
+}
+
+func TestNonceManager_ResyncOnNonceTooLow(t *testing.T) {
+	chainNonce := uint64(3)
+	m := newNonceManager(func(context.Context, common.Address) (uint64, error) {
+		return chainNonce, nil
+	})
+
+	send := func(nonce uint64) (*types.Transaction, error) {
+		if nonce < chainNonce {
+			return newTestTx(nonce), errors.New("nonce too low")
+		}
+		chainNonce = nonce + 1
+		return newTestTx(nonce), nil
+	}
+
+	if _, err := m.send(context.Background(), testAccount, send); err != nil {
+		t.Fatalf("fails to send(), %v", err)
+	}
+
+	// 其他程序使用同一账户发送了交易
+	chainNonce += 2
+	tx, err := m.send(context.Background(), testAccount, send)
+	if err != nil {
+		t.Fatalf("fails to send(), %v", err)
+	}
+	if tx.Nonce() != 6 {
+		t.Fatalf("unexpected nonce after resync: %d", tx.Nonce())
+	}
+}
+
+func TestNonceManager_BroadcastFailed(t *testing.T) {
+	var syncs int
+	m := newNonceManager(func(context.Context, common.Address) (uint64, error) {
+		syncs++
+		return 0, nil
+	})
+
+	// 签名后广播失败，nonce不增加，下次发送前重新同步
+	_, err := m.send(context.Background(), testAccount, func(nonce uint64) (*types.Transaction, error) {
+		return newTestTx(nonce), errors.New("timeout")
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	tx, err := m.send(context.Background(), testAccount, func(nonce uint64) (*types.Transaction, error) {
+		return newTestTx(nonce), nil
+	})
+	if err != nil {
+		t.Fatalf("fails to send(), %v", err)
+	}
+	if tx.Nonce() != 0 || syncs != 2 {
+		t.Fatalf("unexpected nonce %d or syncs %d", tx.Nonce(), syncs)
+	}
+}
+
+func TestNonceManager_Concurrent(t *testing.T) {
+	m := newNonceManager(func(context.Context, common.Address) (uint64, error) {
+		return 0, nil
+	})
+
+	var mu sync.Mutex
+	seen := make(map[uint64]bool)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tx, err := m.send(context.Background(), testAccount, func(nonce uint64) (*types.Transaction, error) {
+				return newTestTx(nonce), nil
+			})
+			if err != nil {
+				t.Errorf("fails to send(), %v", err)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if seen[tx.Nonce()] {
+				t.Errorf("duplicate nonce: %d", tx.Nonce())
+			}
+			seen[tx.Nonce()] = true
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != 20 {
+		t.Fatalf("expected 20 distinct nonces, got %d", len(seen))
+	}
+}
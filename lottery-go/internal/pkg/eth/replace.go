@@ -0,0 +1,382 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/base/logx"
+	"lottery-go/internal/pkg/metrics"
+)
+
+var (
+	// ErrTxCancelled 交易已被取消交易替换
+	ErrTxCancelled = errors.New("transaction cancelled")
+	// ErrTxReplaced 交易的nonce已被其他交易使用
+	ErrTxReplaced = errors.New("transaction replaced by another transaction")
+	// ErrNoPendingTx 账户没有未确认的交易
+	ErrNoPendingTx = errors.New("no pending transaction")
+	// ErrFeeCeiling 加价后超过fee上限，无法满足节点的替换规则
+	ErrFeeCeiling = errors.New("replacement fee exceeds max fee cap")
+)
+
+// ReplacePolicy 未确认交易的加速策略，为0时使用默认值
+type ReplacePolicy struct {
+	AfterBlocks uint64  // 交易经过多少个区块仍未上链时加价重发
+	BumpPercent float64 // 每次加价的比例（%），节点要求替换交易至少加价10%
+	MaxFeeGwei  float64 // 加价后 maxFee（legacy交易为gasPrice）的上限，为0时不限制
+}
+
+const (
+	defaultReplaceAfterBlocks = 5
+	defaultBumpPercent        = 12.5
+
+	// 节点接受替换交易的最低加价比例（%）
+	minBumpPercent = 10
+
+	// 取消交易（0值自转账）的gasLimit
+	cancelGasLimit = 21000
+)
+
+func (p ReplacePolicy) withDefaults() ReplacePolicy {
+	if p.AfterBlocks == 0 {
+		p.AfterBlocks = defaultReplaceAfterBlocks
+	}
+	if p.BumpPercent < minBumpPercent {
+		p.BumpPercent = defaultBumpPercent
+	}
+	return p
+}
+
+type pendingKey struct {
+	account common.Address
+	nonce   uint64
+}
+
+// pendingTx 已广播未确认的交易，同一nonce的加速交易、取消交易依次记录
+type pendingTx struct {
+	account  common.Address
+	nonce    uint64
+	funcName string
+	args     string
+	sign     func(tx *types.Transaction) (*types.Transaction, error)
+
+	mu        sync.Mutex
+	txs       []*types.Transaction
+	cancelled bool // 已发送取消交易，不再加速
+}
+
+func (p *pendingTx) list() []*types.Transaction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*types.Transaction(nil), p.txs...)
+}
+
+// latest 最后一次发送的交易，替换交易需在它的基础上加价
+func (p *pendingTx) latest() (*types.Transaction, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.txs[len(p.txs)-1], p.cancelled
+}
+
+func (p *pendingTx) add(tx *types.Transaction, cancel bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.txs = append(p.txs, tx)
+	p.cancelled = p.cancelled || cancel
+}
+
+// isCancel 是否为取消交易
+func (p *pendingTx) isCancel(tx *types.Transaction) bool {
+	return tx.To() != nil && *tx.To() == p.account && len(tx.Data()) == 0
+}
+
+// waitMined 轮询交易回执直到上链，节点异常时切换节点继续查询。
+// 交易经过 AfterBlocks 个区块仍未上链时，使用相同nonce加价重发，直到达到fee上限。
+func (c *Client) waitMined(ctx context.Context, p *pendingTx, policy ReplacePolicy, pricer GasPricer) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeouts.Confirm)
+	defer cancel()
+
+	ticker := time.NewTicker(receiptPollInterval)
+	defer ticker.Stop()
+
+	var lastBlock, sentBlock uint64
+	nonceUsed := false
+	for {
+		if receipt, tx := c.findReceipt(ctx, p); receipt != nil {
+			if p.isCancel(tx) {
+				return nil, errorx.Wrap("transaction not mined", ErrTxCancelled, "cancelTxHash", tx.Hash().Hex())
+			}
+			return receipt, nil
+		}
+		// 上一轮发现nonce已被使用，再次查询回执后仍未找到
+		if nonceUsed {
+			return nil, ErrTxReplaced
+		}
+
+		if blockNumber, err := c.BlockNumber(ctx); err == nil && blockNumber > lastBlock {
+			lastBlock = blockNumber
+			if sentBlock == 0 {
+				sentBlock = blockNumber
+			}
+
+			if confirmed, err := c.confirmedNonce(ctx, p.account); err == nil && confirmed > p.nonce {
+				nonceUsed = true
+			} else if blockNumber-sentBlock >= policy.AfterBlocks {
+				c.speedUp(ctx, p, policy, pricer)
+				sentBlock = blockNumber
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// findReceipt 查询同一nonce已发送的所有交易，返回已上链交易的回执
+func (c *Client) findReceipt(ctx context.Context, p *pendingTx) (*types.Receipt, *types.Transaction) {
+	for _, tx := range p.list() {
+		var receipt *types.Receipt
+		err := c.withEndpoint(ctx, rpcOp{kind: "receipt", function: p.funcName}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+			var err error
+			receipt, err = client.TransactionReceipt(ctx, tx.Hash())
+			return err
+		})
+		if err == nil {
+			return receipt, tx
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			logx.Warn("failed to get transaction receipt.", "txHash", tx.Hash().Hex(), "err", err)
+		}
+	}
+	return nil, nil
+}
+
+// speedUp 使用相同nonce加价重发交易，失败只记录日志，等待下次加速
+func (c *Client) speedUp(ctx context.Context, p *pendingTx, policy ReplacePolicy, pricer GasPricer) {
+	latest, cancelled := p.latest()
+	if cancelled || p.sign == nil {
+		return
+	}
+
+	price, err := bumpPrice(latest, c.marketPrice(ctx, p.funcName, pricer), policy)
+	if err != nil {
+		logx.Warn("failed to speed up transaction.", "txHash", latest.Hash().Hex(), "err", err)
+		return
+	}
+
+	tx, err := p.sign(withPrice(latest, price))
+	if err == nil {
+		err = c.journalPut(p.account, tx, p.funcName, p.args)
+	}
+	if err != nil {
+		logx.Warn("failed to speed up transaction.", "txHash", latest.Hash().Hex(), "err", err)
+		return
+	}
+	if err = c.broadcast(ctx, p.funcName, tx); err != nil {
+		c.journalRejected(tx, err)
+		logx.Warn("failed to speed up transaction.", "txHash", latest.Hash().Hex(), "err", err)
+		return
+	}
+
+	p.add(tx, false)
+	metrics.TxReplacements.WithLabelValues(p.funcName, "speedUp").Inc()
+	logx.Info("transaction sped up.", "function", p.funcName, "nonce", p.nonce, "oldTxHash", latest.Hash().Hex(),
+		"txHash", tx.Hash().Hex(), "gasPrice", FormatGwei(price.GasPrice), "maxFee", FormatGwei(price.GasFeeCap),
+		"maxTip", FormatGwei(price.GasTipCap))
+}
+
+// marketPrice 当前的市场价格，获取失败时返回nil，仅在原交易基础上加价
+func (c *Client) marketPrice(ctx context.Context, funcName string, pricer GasPricer) *GasPrice {
+	var price *GasPrice
+	err := c.withEndpoint(ctx, rpcOp{kind: "gasPrice", function: funcName}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		price, err = pricer.Price(ctx, client)
+		return err
+	})
+	if err != nil {
+		logx.Warn("failed to get gas price.", "err", err)
+		return nil
+	}
+	return price
+}
+
+// CancelTransaction 使用相同nonce发送0值的自转账交易，替换内存池中未确认的交易，不等待上链。
+// nonce 为nil时取最早的未确认交易，nonce 不在未确认范围内时返回 ErrNoPendingTx。
+func (c *Client) CancelTransaction(ctx context.Context, txCtx *TransactionContext, nonce *uint64) (*types.Transaction, error) {
+	signer, chainID, err := c.signer(ctx, txCtx)
+	if err != nil {
+		return nil, err
+	}
+	account := signer.Address()
+
+	// 持有发送锁，避免并发发送的交易使用同一nonce
+	unlock := c.nonces.lock(account)
+	defer unlock()
+
+	// 只能取消已发送未上链的nonce：小于已上链nonce的交易已结束，不小于pending nonce的会成为一笔新交易，
+	// 占用后续开奖交易的nonce
+	confirmed, err := c.confirmedNonce(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := c.pendingNonce(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if nonce == nil {
+		nonce = &confirmed
+	}
+	if *nonce < confirmed || *nonce >= pending {
+		return nil, errorx.Wrap("nonce out of pending range", ErrNoPendingTx, "nonce", *nonce, "confirmed", confirmed, "pending", pending)
+	}
+
+	policy := txCtx.Replace.withDefaults()
+	market := c.marketPrice(ctx, "cancel", pricerOrDefault(txCtx.GasPricer))
+
+	// 在最后一次发送的交易基础上加价：正在等待确认的交易从内存中获取，
+	// 发送已返回（如等待确认超时、重启后）的交易从交易日志中获取；都没有时在市场价格基础上加价
+	var latest *types.Transaction
+	value, tracked := c.pending.Load(pendingKey{account: account, nonce: *nonce})
+	if tracked {
+		latest, _ = value.(*pendingTx).latest()
+	} else if latest, err = c.journaled(account, *nonce); err != nil {
+		return nil, err
+	}
+
+	var price *GasPrice
+	if latest != nil {
+		price, err = bumpPrice(latest, market, policy)
+	} else if market != nil {
+		price, err = bumpMarketPrice(market, policy)
+	} else {
+		err = errorx.New("failed to get gas price")
+	}
+	if err != nil {
+		return nil, errorx.Wrap("failed to cancel transaction", err, "nonce", *nonce)
+	}
+
+	tx, err := signer.SignTx(ctx, newTransaction(chainID, *nonce, &account, nil, nil, cancelGasLimit, price), chainID)
+	if err != nil {
+		return nil, errorx.Wrap("failed to sign transaction", err)
+	}
+	if err = c.journalPut(account, tx, "cancel", ""); err != nil {
+		return nil, err
+	}
+	if err = c.broadcast(ctx, "cancel", tx); err != nil {
+		c.journalRejected(tx, err)
+		return nil, errorx.Wrap("failed to cancel transaction", err, "nonce", *nonce)
+	}
+
+	if tracked {
+		value.(*pendingTx).add(tx, true)
+	}
+	metrics.TxReplacements.WithLabelValues(txCtx.FuncName, "cancel").Inc()
+	logx.Info("transaction cancelled.", "nonce", *nonce, "txHash", tx.Hash().Hex(), "gasPrice", FormatGwei(price.GasPrice),
+		"maxFee", FormatGwei(price.GasFeeCap), "maxTip", FormatGwei(price.GasTipCap))
+	return tx, nil
+}
+
+// confirmedNonce 获取账户在最新区块的nonce，即已上链的交易数
+func (c *Client) confirmedNonce(ctx context.Context, account common.Address) (uint64, error) {
+	var nonce uint64
+	err := c.withEndpoint(ctx, rpcOp{kind: "nonce"}, c.timeouts.Call, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		nonce, err = client.NonceAt(ctx, account, nil)
+		return err
+	})
+	if err != nil {
+		return 0, errorx.Wrap("failed to get nonce", err, "account", account.Hex())
+	}
+	return nonce, nil
+}
+
+// bumpPrice 在原交易的基础上加价，且不低于当前市场价格；加价后不满足替换规则时返回 ErrFeeCeiling
+func bumpPrice(old *types.Transaction, market *GasPrice, policy ReplacePolicy) (*GasPrice, error) {
+	maxFee := gweiToWei(policy.MaxFeeGwei)
+	factor := 1 + policy.BumpPercent/100
+
+	if old.Type() == types.LegacyTxType {
+		gasPrice := mulFloat(old.GasPrice(), factor)
+		if market != nil {
+			gasPrice = maxBig(gasPrice, maxBig(market.GasPrice, market.GasFeeCap))
+		}
+		gasPrice = capFee(gasPrice, maxFee)
+		if !isReplaceable(old.GasPrice(), gasPrice) {
+			return nil, ErrFeeCeiling
+		}
+		return &GasPrice{GasPrice: gasPrice}, nil
+	}
+
+	tip := mulFloat(old.GasTipCap(), factor)
+	feeCap := mulFloat(old.GasFeeCap(), factor)
+	if market != nil && !market.IsLegacy() {
+		tip = maxBig(tip, market.GasTipCap)
+		feeCap = maxBig(feeCap, market.GasFeeCap)
+	}
+	feeCap = capFee(feeCap, maxFee)
+	if tip.Cmp(feeCap) > 0 {
+		tip = feeCap
+	}
+	if !isReplaceable(old.GasTipCap(), tip) || !isReplaceable(old.GasFeeCap(), feeCap) {
+		return nil, ErrFeeCeiling
+	}
+	return &GasPrice{GasTipCap: tip, GasFeeCap: feeCap}, nil
+}
+
+// bumpMarketPrice 不知道原交易价格时，在市场价格基础上加价
+func bumpMarketPrice(market *GasPrice, policy ReplacePolicy) (*GasPrice, error) {
+	maxFee := gweiToWei(policy.MaxFeeGwei)
+	factor := 1 + policy.BumpPercent/100
+
+	if market.IsLegacy() {
+		return &GasPrice{GasPrice: capFee(mulFloat(market.GasPrice, factor), maxFee)}, nil
+	}
+
+	feeCap := capFee(mulFloat(market.GasFeeCap, factor), maxFee)
+	tip := mulFloat(market.GasTipCap, factor)
+	if tip.Cmp(feeCap) > 0 {
+		tip = feeCap
+	}
+	return &GasPrice{GasTipCap: tip, GasFeeCap: feeCap}, nil
+}
+
+// withPrice 复制交易并替换gas价格，nonce、gasLimit、调用数据不变
+func withPrice(tx *types.Transaction, price *GasPrice) *types.Transaction {
+	if price.IsLegacy() {
+		return types.NewTx(&types.LegacyTx{Nonce: tx.Nonce(), GasPrice: price.GasPrice, Gas: tx.Gas(),
+			To: tx.To(), Value: tx.Value(), Data: tx.Data()})
+	}
+	return types.NewTx(&types.DynamicFeeTx{ChainID: tx.ChainId(), Nonce: tx.Nonce(), GasTipCap: price.GasTipCap,
+		GasFeeCap: price.GasFeeCap, Gas: tx.Gas(), To: tx.To(), Value: tx.Value(), Data: tx.Data()})
+}
+
+// isReplaceable 新价格是否比原价格至少高 minBumpPercent
+func isReplaceable(old, bumped *big.Int) bool {
+	required := new(big.Int).Mul(old, big.NewInt(100+minBumpPercent))
+	return new(big.Int).Mul(bumped, big.NewInt(100)).Cmp(required) >= 0
+}
+
+func capFee(fee, maxFee *big.Int) *big.Int {
+	if maxFee.Sign() > 0 && fee.Cmp(maxFee) > 0 {
+		return maxFee
+	}
+	return fee
+}
+
+func maxBig(a, b *big.Int) *big.Int {
+	if b == nil || (a != nil && a.Cmp(b) >= 0) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,187 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// fixedPricer 固定的市场价格
+type fixedPricer struct {
+	price *GasPrice
+}
+
+func (p fixedPricer) Price(context.Context, GasBackend) (*GasPrice, error) {
+	return p.price, nil
+}
+
+func newDynamicTx(tipGwei, feeCapGwei int64) *types.Transaction {
+	to := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	return types.NewTx(&types.DynamicFeeTx{Nonce: 3, GasTipCap: gwei(tipGwei), GasFeeCap: gwei(feeCapGwei),
+		Gas: 50000, To: &to, Data: []byte{1, 2, 3, 4}})
+}
+
+func TestBumpPrice_Dynamic(t *testing.T) {
+	policy := ReplacePolicy{}.withDefaults()
+
+	// 市场价格低于加价后的价格时，按比例加价
+	price, err := bumpPrice(newDynamicTx(2, 20), &GasPrice{GasTipCap: gwei(1), GasFeeCap: gwei(10)}, policy)
+	if err != nil {
+		t.Fatalf("fails to bumpPrice(), %v", err)
+	}
+	if FormatGwei(price.GasTipCap) != "2.250" || FormatGwei(price.GasFeeCap) != "22.500" {
+		t.Fatalf("unexpected price: tip=%s, maxFee=%s", FormatGwei(price.GasTipCap), FormatGwei(price.GasFeeCap))
+	}
+
+	// 市场价格更高时使用市场价格
+	price, err = bumpPrice(newDynamicTx(2, 20), &GasPrice{GasTipCap: gwei(5), GasFeeCap: gwei(40)}, policy)
+	if err != nil {
+		t.Fatalf("fails to bumpPrice(), %v", err)
+	}
+	if price.GasTipCap.Cmp(gwei(5)) != 0 || price.GasFeeCap.Cmp(gwei(40)) != 0 {
+		t.Fatalf("unexpected price: tip=%s, maxFee=%s", FormatGwei(price.GasTipCap), FormatGwei(price.GasFeeCap))
+	}
+}
+
+func TestBumpPrice_FeeCeiling(t *testing.T) {
+	policy := ReplacePolicy{MaxFeeGwei: 21}.withDefaults()
+
+	// 上限不足以加价10%
+	if _, err := bumpPrice(newDynamicTx(2, 20), nil, policy); !errors.Is(err, ErrFeeCeiling) {
+		t.Fatalf("expected ErrFeeCeiling, got %v", err)
+	}
+
+	policy.MaxFeeGwei = 22
+	price, err := bumpPrice(newDynamicTx(2, 20), nil, policy)
+	if err != nil {
+		t.Fatalf("fails to bumpPrice(), %v", err)
+	}
+	if price.GasFeeCap.Cmp(gwei(22)) != 0 {
+		t.Fatalf("expected capped max fee, got %s", FormatGwei(price.GasFeeCap))
+	}
+}
+
+func TestBumpPrice_Legacy(t *testing.T) {
+	old := types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: gwei(10), Gas: 21000})
+
+	price, err := bumpPrice(old, nil, ReplacePolicy{}.withDefaults())
+	if err != nil {
+		t.Fatalf("fails to bumpPrice(), %v", err)
+	}
+	if !price.IsLegacy() || FormatGwei(price.GasPrice) != "11.250" {
+		t.Fatalf("unexpected price: %+v", price)
+	}
+}
+
+func TestWithPrice(t *testing.T) {
+	old := newDynamicTx(2, 20)
+	tx := withPrice(old, &GasPrice{GasTipCap: gwei(3), GasFeeCap: gwei(30)})
+
+	if tx.Nonce() != old.Nonce() || tx.Gas() != old.Gas() || *tx.To() != *old.To() || string(tx.Data()) != string(old.Data()) {
+		t.Fatal("expected same nonce, gas, to and data")
+	}
+	if tx.GasTipCap().Cmp(gwei(3)) != 0 || tx.GasFeeCap().Cmp(gwei(30)) != 0 {
+		t.Fatal("expected new price")
+	}
+}
+
+// nonceResult 模拟 eth_getTransactionCount：已上链nonce为 confirmed，pending nonce 为 pending
+func nonceResult(params []json.RawMessage, confirmed, pending uint64) string {
+	if len(params) > 1 && string(params[1]) == `"pending"` {
+		return hexutil.EncodeUint64(pending)
+	}
+	return hexutil.EncodeUint64(confirmed)
+}
+
+func TestCancelTransaction_JournaledAboveMarket(t *testing.T) {
+	var sent *types.Transaction
+	server, _ := newChainServer(t, func(method string, params []json.RawMessage) interface{} {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_getTransactionCount":
+			return nonceResult(params, 3, 4)
+		case "eth_sendRawTransaction":
+			var raw hexutil.Bytes
+			_ = json.Unmarshal(params[0], &raw)
+			sent = new(types.Transaction)
+			if err := sent.UnmarshalBinary(raw); err != nil {
+				t.Errorf("fails to decode transaction, %v", err)
+			}
+			return sent.Hash().Hex()
+		}
+		return nil
+	})
+
+	client, err := NewClient(Options{Endpoints: []Endpoint{{Url: server.URL}}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	defer client.Close()
+
+	// 发送已返回的交易只在交易日志中，价格（tip 50、maxFee 100 gwei）高于当前市场价格
+	stuck, account := newSignedTx(t, 3, common.HexToAddress("0x02"), []byte{1}, 50)
+	if err = client.journalPut(account, stuck, "drawLottery", "[5]"); err != nil {
+		t.Fatalf("fails to journalPut(), %v", err)
+	}
+
+	signer, err := NewHexKeySigner("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce := uint64(3)
+	tx, err := client.CancelTransaction(context.Background(), &TransactionContext{FuncName: "drawLottery", Signer: signer,
+		GasPricer: fixedPricer{price: &GasPrice{GasTipCap: gwei(1), GasFeeCap: gwei(2)}}}, &nonce)
+	if err != nil {
+		t.Fatalf("fails to CancelTransaction(), %v", err)
+	}
+	if sent == nil || sent.Hash() != tx.Hash() || tx.Nonce() != 3 || *tx.To() != account {
+		t.Fatalf("unexpected cancel transaction %+v", tx)
+	}
+	// 在原交易基础上加价，满足节点的替换规则
+	if !isReplaceable(stuck.GasTipCap(), tx.GasTipCap()) || !isReplaceable(stuck.GasFeeCap(), tx.GasFeeCap()) {
+		t.Fatalf("cancel underpriced, tip %s maxFee %s", FormatGwei(tx.GasTipCap()), FormatGwei(tx.GasFeeCap()))
+	}
+}
+
+func TestCancelTransaction_NonceOutOfRange(t *testing.T) {
+	var sent bool
+	server, _ := newChainServer(t, func(method string, params []json.RawMessage) interface{} {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_getTransactionCount":
+			return nonceResult(params, 3, 4)
+		case "eth_sendRawTransaction":
+			sent = true
+		}
+		return nil
+	})
+
+	client, err := NewClient(Options{Endpoints: []Endpoint{{Url: server.URL}}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	defer client.Close()
+
+	signer, err := NewHexKeySigner("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 只有nonce 3未上链：2已上链，4及以上会成为新交易
+	for _, nonce := range []uint64{2, 4, 10} {
+		_, err = client.CancelTransaction(context.Background(), &TransactionContext{FuncName: "drawLottery", Signer: signer,
+			GasPricer: fixedPricer{price: &GasPrice{GasTipCap: gwei(1), GasFeeCap: gwei(2)}}}, &nonce)
+		if !errors.Is(err, ErrNoPendingTx) {
+			t.Fatalf("nonce %d: expected ErrNoPendingTx, got %v", nonce, err)
+		}
+	}
+	if sent {
+		t.Fatal("transaction should not be sent")
+	}
+}
@@ -0,0 +1,196 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"lottery-go/internal/base/errorx"
+)
+
+// Signer 交易签名者，私钥可以在本地（keystore、密钥文件），也可以在远程签名服务（Clef、Web3Signer）
+type Signer interface {
+	// Address 签名账户地址
+	Address() common.Address
+	// SignTx 对交易签名，返回已签名的交易
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// KeySigner 使用内存中的私钥签名
+type KeySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+func NewKeySigner(key *ecdsa.PrivateKey) *KeySigner {
+	return &KeySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// NewHexKeySigner 使用hex编码的私钥签名，私钥不应明文写在配置文件中，建议使用 keystore 或密钥文件
+func NewHexKeySigner(hexKey string) (*KeySigner, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return nil, errorx.Wrap("failed to parse private key", err)
+	}
+	return NewKeySigner(key), nil
+}
+
+// NewKeyFileSigner 从挂载的密钥文件（如 k8s secret）读取hex编码的私钥
+func NewKeyFileSigner(path string) (*KeySigner, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errorx.Wrap("failed to read key file", err, "path", path)
+	}
+	return NewHexKeySigner(string(data))
+}
+
+// NewKeystoreSigner 解密 go-ethereum 格式的加密 keystore 文件
+func NewKeystoreSigner(path, password string) (*KeySigner, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errorx.Wrap("failed to read keystore", err, "path", path)
+	}
+
+	key, err := keystore.DecryptKey(data, password)
+	if err != nil {
+		return nil, errorx.Wrap("failed to decrypt keystore", err, "path", path)
+	}
+	return NewKeySigner(key.PrivateKey), nil
+}
+
+func (s *KeySigner) Address() common.Address {
+	return s.address
+}
+
+func (s *KeySigner) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+	if err != nil {
+		return nil, errorx.Wrap("failed to sign transaction", err)
+	}
+	return signed, nil
+}
+
+// RemoteSigner 通过 eth_signTransaction JSON-RPC 调用远程签名服务（Clef、Web3Signer），私钥不离开签名服务
+type RemoteSigner struct {
+	client  *rpc.Client
+	url     string
+	address common.Address
+}
+
+func NewRemoteSigner(url string, address common.Address) (*RemoteSigner, error) {
+	client, err := rpc.Dial(url)
+	if err != nil {
+		return nil, errorx.Wrap("failed to connect remote signer", err, "url", url)
+	}
+	return &RemoteSigner{client: client, url: url, address: address}, nil
+}
+
+// Close 关闭与签名服务的连接
+func (s *RemoteSigner) Close() {
+	s.client.Close()
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// signTxArgs eth_signTransaction 的参数
+type signTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+func (s *RemoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := signTxArgs{
+		From:    s.address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.Type() == types.LegacyTxType {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	} else {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	}
+
+	var result json.RawMessage
+	if err := s.client.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
+		return nil, errorx.Wrap("remote signer failed to sign transaction", err, "url", s.url)
+	}
+
+	signed, err := decodeSignResult(result)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.verify(tx, signed, chainID); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+// decodeSignResult Clef 返回 {raw, tx}，Web3Signer 直接返回已签名交易的hex
+func decodeSignResult(result json.RawMessage) (*types.Transaction, error) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		var clef struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err = json.Unmarshal(result, &clef); err != nil {
+			return nil, errorx.Wrap("invalid remote signer response", err)
+		}
+		raw = clef.Raw
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, errorx.Wrap("invalid signed transaction", err)
+	}
+	return signed, nil
+}
+
+// verify 校验签名账户和交易内容，防止签名服务返回被修改的交易
+func (s *RemoteSigner) verify(tx, signed *types.Transaction, chainID *big.Int) error {
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	if err != nil {
+		return errorx.Wrap("invalid transaction signature", err)
+	}
+	if sender != s.address {
+		return errorx.New("transaction signed by unexpected account", "expected", s.address.Hex(), "actual", sender.Hex())
+	}
+
+	if signed.Nonce() != tx.Nonce() || signed.Gas() != tx.Gas() || signed.Value().Cmp(tx.Value()) != 0 ||
+		signed.GasFeeCap().Cmp(tx.GasFeeCap()) != 0 || signed.GasTipCap().Cmp(tx.GasTipCap()) != 0 ||
+		!sameAddress(signed.To(), tx.To()) || !bytes.Equal(signed.Data(), tx.Data()) {
+		return errorx.New("signed transaction does not match request", "txHash", signed.Hash().Hex())
+	}
+	return nil
+}
+
+func sameAddress(a, b *common.Address) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
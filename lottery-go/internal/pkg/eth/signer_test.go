@@ -0,0 +1,149 @@
+package eth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+var testChainID = big.NewInt(11155111)
+
+func newUnsignedTx() *types.Transaction {
+	to := common.HexToAddress("0x02")
+	return types.NewTx(&types.DynamicFeeTx{ChainID: testChainID, Nonce: 3, GasTipCap: gwei(1), GasFeeCap: gwei(30),
+		Gas: 60000, To: &to, Data: []byte{1, 2, 3}})
+}
+
+// assertSignedBy 校验交易由 key 签名
+func assertSignedBy(t *testing.T, tx *types.Transaction, address common.Address) {
+	t.Helper()
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), tx)
+	if err != nil {
+		t.Fatalf("invalid signature, %v", err)
+	}
+	if sender != address {
+		t.Fatalf("signed by %s, want %s", sender.Hex(), address.Hex())
+	}
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	id, _ := uuid.NewRandom()
+	data, err := keystore.EncryptKey(&keystore.Key{Id: id, Address: crypto.PubkeyToAddress(key.PublicKey), PrivateKey: key},
+		"secret", keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "operator.json")
+	_ = os.WriteFile(path, data, 0o600)
+
+	if _, err = NewKeystoreSigner(path, "wrong"); err == nil {
+		t.Fatal("expected error with wrong password")
+	}
+
+	signer, err := NewKeystoreSigner(path, "secret")
+	if err != nil {
+		t.Fatalf("fails to NewKeystoreSigner(), %v", err)
+	}
+	tx, err := signer.SignTx(context.Background(), newUnsignedTx(), testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSignedBy(t, tx, crypto.PubkeyToAddress(key.PublicKey))
+}
+
+func TestKeyFileSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	path := filepath.Join(t.TempDir(), "operator_key")
+	_ = os.WriteFile(path, []byte("0x"+common.Bytes2Hex(crypto.FromECDSA(key))+"\n"), 0o600)
+
+	signer, err := NewKeyFileSigner(path)
+	if err != nil {
+		t.Fatalf("fails to NewKeyFileSigner(), %v", err)
+	}
+	if signer.Address() != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("unexpected address %s", signer.Address().Hex())
+	}
+}
+
+// newSignerServer 模拟 Clef、Web3Signer 的 eth_signTransaction，clef 为true时返回 {raw, tx}
+func newSignerServer(t *testing.T, key *ecdsa.PrivateKey, clef bool) string {
+	server, _ := newChainServer(t, func(method string, params []json.RawMessage) interface{} {
+		if method != "eth_signTransaction" {
+			return nil
+		}
+
+		var args signTxArgs
+		if err := json.Unmarshal(params[0], &args); err != nil {
+			t.Errorf("invalid args, %v", err)
+			return nil
+		}
+		if args.From != crypto.PubkeyToAddress(key.PublicKey) {
+			return &rpcError{Code: -32000, Message: "unknown account"}
+		}
+
+		tx := types.NewTx(&types.DynamicFeeTx{ChainID: args.ChainID.ToInt(), Nonce: uint64(args.Nonce),
+			GasTipCap: args.MaxPriorityFeePerGas.ToInt(), GasFeeCap: args.MaxFeePerGas.ToInt(), Gas: uint64(args.Gas),
+			To: args.To, Value: args.Value.ToInt(), Data: args.Data})
+		signed, _ := types.SignTx(tx, types.LatestSignerForChainID(args.ChainID.ToInt()), key)
+		raw, _ := signed.MarshalBinary()
+		if clef {
+			return map[string]interface{}{"raw": hexutil.Encode(raw), "tx": signed}
+		}
+		return hexutil.Encode(raw)
+	})
+	return server.URL
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	for name, clef := range map[string]bool{"clef": true, "web3signer": false} {
+		t.Run(name, func(t *testing.T) {
+			signer, err := NewRemoteSigner(newSignerServer(t, key, clef), address)
+			if err != nil {
+				t.Fatalf("fails to NewRemoteSigner(), %v", err)
+			}
+			defer signer.Close()
+
+			unsigned := newUnsignedTx()
+			tx, err := signer.SignTx(context.Background(), unsigned, testChainID)
+			if err != nil {
+				t.Fatalf("fails to SignTx(), %v", err)
+			}
+			assertSignedBy(t, tx, address)
+			if tx.Nonce() != unsigned.Nonce() || tx.GasFeeCap().Cmp(unsigned.GasFeeCap()) != 0 {
+				t.Fatal("signed transaction does not match request")
+			}
+		})
+	}
+}
+
+func TestRemoteSigner_UnknownAccount(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+
+	signer, err := NewRemoteSigner(newSignerServer(t, key, true), crypto.PubkeyToAddress(other.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer signer.Close()
+
+	if _, err = signer.SignTx(context.Background(), newUnsignedTx(), testChainID); err == nil ||
+		!strings.Contains(err.Error(), "unknown account") {
+		t.Fatalf("expected unknown account error, got %v", err)
+	}
+}
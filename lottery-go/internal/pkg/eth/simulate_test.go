@@ -0,0 +1,58 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const testErrorABI = `[{"type":"error","name":"MinDrawIntervalNotMet","inputs":[
+	{"name":"startTime","type":"uint256"},{"name":"currentTime","type":"uint256"}]}]`
+
+func TestSimulate_Revert(t *testing.T) {
+	errorABI, err := abi.JSON(strings.NewReader(testErrorABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	abiErr := errorABI.Errors["MinDrawIntervalNotMet"]
+	args, _ := abiErr.Inputs.Pack(big.NewInt(100), big.NewInt(50))
+	data := append(abiErr.ID[:4:4], args...)
+
+	var sent bool
+	server, _ := newChainServer(t, func(method string, params []json.RawMessage) interface{} {
+		switch method {
+		case "eth_call":
+			if len(params) < 2 || string(params[1]) != `"pending"` {
+				t.Errorf("expected call at pending block, got %s", params)
+			}
+			return &rpcError{Code: 3, Message: "execution reverted", Data: hexutil.Encode(data)}
+		case "eth_sendRawTransaction":
+			sent = true
+		}
+		return nil
+	})
+
+	client, err := NewClient(Options{Endpoints: []Endpoint{{Url: server.URL}}})
+	if err != nil {
+		t.Fatalf("fails to NewClient(), %v", err)
+	}
+	defer client.Close()
+
+	err = client.simulate(context.Background(), common.Address{}, common.HexToAddress("0x02"), []byte{1},
+		&TransactionContext{FuncName: "drawLottery", ErrorAbi: testErrorABI})
+
+	var contractErr *ContractError
+	if !errors.As(err, &contractErr) || contractErr.Type != "MinDrawIntervalNotMet" {
+		t.Fatalf("expected MinDrawIntervalNotMet, got %v", err)
+	}
+	if sent {
+		t.Fatal("transaction should not be sent")
+	}
+}
@@ -0,0 +1,72 @@
+// Package health 提供存活、就绪检查的通用实现
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"lottery-go/internal/base/errorx"
+)
+
+// Check 单项检查，Fn 返回 nil 表示通过
+type Check struct {
+	Name string
+	Fn   func(ctx context.Context) error
+}
+
+// Result 单项检查结果
+type Result struct {
+	Name     string `json:"name"`
+	Ok       bool   `json:"ok"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Report 检查报告，所有检查通过时 Ok 为 true
+type Report struct {
+	Ok     bool      `json:"ok"`
+	Checks []*Result `json:"checks"`
+}
+
+// Run 并发执行所有检查，每项检查的超时时间为 timeout
+func Run(ctx context.Context, timeout time.Duration, checks []Check) *Report {
+	results := make([]*Result, len(checks))
+
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Fn(checkCtx)
+			results[i] = &Result{Name: check.Name, Ok: err == nil, Duration: time.Since(start).String()}
+			if err != nil {
+				results[i].Error = err.Error()
+			}
+		}(i, check)
+	}
+	wg.Wait()
+
+	report := &Report{Ok: true, Checks: results}
+	for _, result := range results {
+		report.Ok = report.Ok && result.Ok
+	}
+	return report
+}
+
+// Err 未通过的检查合并成一个 error，全部通过时返回 nil
+func (r *Report) Err() error {
+	var errs []error
+	for _, result := range r.Checks {
+		if !result.Ok {
+			errs = append(errs, errorx.New("health check failed", "name", result.Name, "err", result.Error))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,38 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	report := Run(context.Background(), time.Second, []Check{
+		{Name: "ok", Fn: func(context.Context) error { return nil }},
+		{Name: "failed", Fn: func(context.Context) error { return errors.New("boom") }},
+	})
+
+	if report.Ok {
+		t.Fatal("expected report not ok")
+	}
+	if !report.Checks[0].Ok || report.Checks[1].Ok || report.Checks[1].Error != "boom" {
+		t.Fatalf("unexpected results: %+v, %+v", report.Checks[0], report.Checks[1])
+	}
+	if report.Err() == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRun_Timeout(t *testing.T) {
+	report := Run(context.Background(), 10*time.Millisecond, []Check{
+		{Name: "slow", Fn: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	})
+
+	if report.Ok || report.Checks[0].Error != context.DeadlineExceeded.Error() {
+		t.Fatalf("expected timeout, got %+v", report.Checks[0])
+	}
+}
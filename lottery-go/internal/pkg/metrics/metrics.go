@@ -0,0 +1,120 @@
+// Package metrics 定义 Prometheus 监控指标
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "lottery"
+
+// RPC调用结果
+const (
+	ResultOk     = "ok"
+	ResultRevert = "revert" // 合约revert等节点正常返回的错误
+	ResultError  = "error"
+)
+
+var (
+	// RpcRequests 每个节点的RPC请求次数，kind：call、send、receipt、chainId等
+	RpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_requests_total",
+		Help:      "Total number of RPC requests by kind, contract function, endpoint and result.",
+	}, []string{"kind", "function", "endpoint", "result"})
+
+	// RpcDuration 每个节点的RPC请求耗时
+	RpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_request_duration_seconds",
+		Help:      "RPC request latency by kind, contract function and endpoint.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"kind", "function", "endpoint"})
+
+	// TxGasUsed 交易消耗的gas
+	TxGasUsed = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "tx_gas_used",
+		Help:      "Gas used by mined transactions.",
+		Buckets:   prometheus.ExponentialBuckets(21000, 2, 8),
+	}, []string{"function"})
+
+	// TxGasPrice 交易实际支付的gas价格（gwei）
+	TxGasPrice = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "tx_effective_gas_price_gwei",
+		Help:      "Effective gas price paid by mined transactions, in gwei.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12),
+	}, []string{"function"})
+
+	// TxFeePaid 累计支付的交易手续费（eth）
+	TxFeePaid = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tx_fee_paid_eth_total",
+		Help:      "Total transaction fee paid, in eth.",
+	}, []string{"function"})
+
+	// TxReplacements 未确认交易的替换次数，kind：speedUp（加价重发）、cancel（取消）
+	TxReplacements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tx_replacements_total",
+		Help:      "Total number of pending transactions replaced by speed-up or cancel.",
+	}, []string{"function", "kind"})
+
+	// TxOutcomes 交易的最终结果：Confirmed、Reorged、Reverted、Dropped
+	TxOutcomes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tx_outcomes_total",
+		Help:      "Total number of transactions by function and final outcome.",
+	}, []string{"function", "outcome"})
+
+	// WalletBalance 发送交易账户的余额（eth）
+	WalletBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "wallet_balance_eth",
+		Help:      "Balance of the operator wallet, in eth.",
+	}, []string{"address"})
+
+	// DrawAttempts 开奖尝试次数，result：success、pending（开奖中）、failure
+	DrawAttempts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "draw_attempts_total",
+		Help:      "Total number of draw attempts by lottery number and result.",
+	}, []string{"lottery_number", "result"})
+
+	// LastDrawSuccess 最近一次开奖成功的时间戳
+	LastDrawSuccess = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "last_successful_draw_timestamp_seconds",
+		Help:      "Unix timestamp of the last successful draw.",
+	})
+
+	// ScratchCardPending 尚未开奖的刮刮卡购买数，state：pending（等待中）、stuck（超时未开奖）
+	ScratchCardPending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "scratch_card_pending_requests",
+		Help:      "Number of scratch card purchases without a lottery result, by state.",
+	}, []string{"state"})
+
+	// JobDuration 定时任务执行耗时
+	JobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "job_duration_seconds",
+		Help:      "Job execution duration.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12),
+	}, []string{"job"})
+
+	// JobPanics 定时任务 panic 次数
+	JobPanics = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_panics_total",
+		Help:      "Total number of panics recovered from jobs.",
+	})
+)
+
+// ObserveJob 记录任务耗时，用法：defer metrics.ObserveJob(name, time.Now())
+func ObserveJob(job string, start time.Time) {
+	JobDuration.WithLabelValues(job).Observe(time.Since(start).Seconds())
+}
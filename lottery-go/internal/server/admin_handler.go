@@ -0,0 +1,184 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"lottery-go/internal/application"
+	"lottery-go/internal/contract"
+	"lottery-go/internal/job"
+	"lottery-go/internal/pkg/eth"
+)
+
+// 历史记录默认返回条数
+const defaultHistoryLimit = 30
+
+// AdminHandler 开奖服务的管理接口
+type AdminHandler struct {
+	dailyLotteryApp *application.DailyLotteryApplication
+	drawLotteryJob  *job.DrawLotteryJob
+	jobSwitch       *job.Switch
+}
+
+func NewAdminHandler(dailyLotteryApp *application.DailyLotteryApplication, drawLotteryJob *job.DrawLotteryJob,
+	jobSwitch *job.Switch) *AdminHandler {
+	return &AdminHandler{dailyLotteryApp: dailyLotteryApp, drawLotteryJob: drawLotteryJob, jobSwitch: jobSwitch}
+}
+
+// Register 注册管理接口，查询接口会暴露operator地址、交易哈希和VRF诊断信息，同样需要令牌
+func (h *AdminHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/lottery", requireToken(h.lottery))
+	mux.HandleFunc("GET /api/jobs", requireToken(h.jobs))
+	mux.HandleFunc("POST /api/jobs/pause", requireToken(h.pause))
+	mux.HandleFunc("POST /api/jobs/resume", requireToken(h.resume))
+	mux.HandleFunc("GET /api/jobs/draw-lottery/today", requireToken(h.today))
+	mux.HandleFunc("GET /api/jobs/draw-lottery/history", requireToken(h.history))
+	mux.HandleFunc("POST /api/jobs/draw-lottery/draw", requireToken(h.drawNow))
+	mux.HandleFunc("POST /api/jobs/draw-lottery/rerequest", requireToken(h.reRequestRandomness))
+	mux.HandleFunc("POST /api/transactions/cancel", requireToken(h.cancelTransaction))
+}
+
+type lotteryResponse struct {
+	LotteryNumber uint64 `json:"lotteryNumber"`
+	DrawState     string `json:"drawState"`
+}
+
+// lottery 当前期号及开奖状态
+func (h *AdminHandler) lottery(w http.ResponseWriter, r *http.Request) {
+	lotteryNumber, err := h.dailyLotteryApp.CurrentLotteryNumber(r.Context())
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	state, err := h.dailyLotteryApp.DrawState(r.Context(), lotteryNumber)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &lotteryResponse{LotteryNumber: lotteryNumber, DrawState: state.String()})
+}
+
+type jobsResponse struct {
+	Paused bool `json:"paused"`
+}
+
+// jobs 定时任务状态
+func (h *AdminHandler) jobs(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, &jobsResponse{Paused: h.jobSwitch.Paused()})
+}
+
+func (h *AdminHandler) pause(w http.ResponseWriter, _ *http.Request) {
+	h.jobSwitch.Pause()
+	writeJSON(w, http.StatusOK, &jobsResponse{Paused: true})
+}
+
+func (h *AdminHandler) resume(w http.ResponseWriter, _ *http.Request) {
+	h.jobSwitch.Resume()
+	writeJSON(w, http.StatusOK, &jobsResponse{Paused: false})
+}
+
+// today 当天的开奖任务记录
+func (h *AdminHandler) today(w http.ResponseWriter, _ *http.Request) {
+	record, err := h.drawLotteryJob.Today()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if record == nil {
+		writeError(w, http.StatusNotFound, errors.New("record not found"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, record)
+}
+
+// history 开奖任务历史记录，参数 limit 默认30
+func (h *AdminHandler) history(w http.ResponseWriter, r *http.Request) {
+	limit := defaultHistoryLimit
+	if s := r.URL.Query().Get("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil || limit <= 0 {
+			writeError(w, http.StatusBadRequest, errors.New("invalid limit"))
+			return
+		}
+	}
+
+	records, err := h.drawLotteryJob.History(limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, records)
+}
+
+// drawNow 手动触发开奖，返回执行后的当天任务记录
+func (h *AdminHandler) drawNow(w http.ResponseWriter, _ *http.Request) {
+	record, err := h.drawLotteryJob.DrawNow()
+	if errors.Is(err, job.ErrJobRunning) {
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, record)
+}
+
+// reRequestRandomness 当天的开奖卡住时重新请求随机数，返回更新后的当天任务记录；
+// 未卡住或合约不允许重新请求（provider 仍有未完成的VRF请求）时返回409
+func (h *AdminHandler) reRequestRandomness(w http.ResponseWriter, _ *http.Request) {
+	record, err := h.drawLotteryJob.ReRequestRandomness()
+	var vrfErr *contract.VRFRequestAlreadyRequestedError
+	if errors.As(err, &vrfErr) {
+		writeError(w, http.StatusConflict, fmt.Errorf("provider has an outstanding vrf request, "+
+			"wait for the coordinator to fulfill it: %w", err))
+		return
+	}
+	if errors.Is(err, job.ErrJobRunning) || errors.Is(err, job.ErrNotStuck) {
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, record)
+}
+
+type cancelResponse struct {
+	TxHash string `json:"txHash"`
+}
+
+// cancelTransaction 发送0值自转账取消未确认的开奖交易，参数 nonce 为空时取消最早的一笔；
+// 没有未确认的交易或 nonce 不在未确认范围内时返回400
+func (h *AdminHandler) cancelTransaction(w http.ResponseWriter, r *http.Request) {
+	var nonce *uint64
+	if s := r.URL.Query().Get("nonce"); s != "" {
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errors.New("invalid nonce"))
+			return
+		}
+		nonce = &n
+	}
+
+	txHash, err := h.dailyLotteryApp.CancelPending(r.Context(), nonce)
+	if errors.Is(err, eth.ErrNoPendingTx) {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &cancelResponse{TxHash: txHash})
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"lottery-go/internal/base/lifecycle"
+	"lottery-go/internal/job"
+	"lottery-go/internal/pkg/alert"
+)
+
+const testApiToken = "secret"
+
+// blockingStore 读取记录时阻塞到 release 关闭，用于模拟进行中的开奖
+type blockingStore struct {
+	job.RecordStore
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingStore) Get(day string) (*job.Record, error) {
+	close(s.started)
+	<-s.release
+	return &job.Record{Day: day, IsDrawn: true}, nil
+}
+
+// newTestMux 注册管理接口，store 为开奖任务的记录存储
+func newTestMux(t *testing.T, store job.RecordStore) (*http.ServeMux, *job.Switch) {
+	t.Helper()
+	cfg = &Cfg{Http: HttpCfg{ApiToken: testApiToken}}
+
+	lc, cancel := lifecycle.New()
+	t.Cleanup(cancel)
+	alertManager := alert.NewManager(alert.NewMultiNotifier(), alert.NewMultiNotifier(), time.Hour, 3)
+	jobSwitch := job.NewSwitch()
+
+	mux := http.NewServeMux()
+	NewAdminHandler(nil, job.NewDrawLotteryJob(lc, nil, store, alertManager), jobSwitch).Register(mux)
+	return mux, jobSwitch
+}
+
+func serve(mux *http.ServeMux, method, target, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	return w
+}
+
+func TestAdminHandler_RequireToken(t *testing.T) {
+	mux, jobSwitch := newTestMux(t, job.NewMemoryRecordStore())
+
+	for name, token := range map[string]string{"missing": "", "wrong": "guess"} {
+		if w := serve(mux, http.MethodPost, "/api/jobs/pause", token); w.Code != http.StatusUnauthorized {
+			t.Fatalf("%s token: expected 401, got %d", name, w.Code)
+		}
+		// 查询接口同样需要令牌
+		for _, target := range []string{"/api/lottery", "/api/jobs", "/api/jobs/draw-lottery/today", "/api/jobs/draw-lottery/history"} {
+			if w := serve(mux, http.MethodGet, target, token); w.Code != http.StatusUnauthorized {
+				t.Fatalf("%s token: expected 401 for %s, got %d", name, target, w.Code)
+			}
+		}
+	}
+	if jobSwitch.Paused() {
+		t.Fatal("jobs paused without a valid token")
+	}
+
+	// 未配置令牌时拒绝所有操作
+	cfg.Http.ApiToken = ""
+	if w := serve(mux, http.MethodPost, "/api/jobs/pause", testApiToken); w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 without configured token, got %d", w.Code)
+	}
+}
+
+func TestAdminHandler_PauseResume(t *testing.T) {
+	mux, jobSwitch := newTestMux(t, job.NewMemoryRecordStore())
+
+	paused := func() bool {
+		var resp jobsResponse
+		w := serve(mux, http.MethodGet, "/api/jobs", testApiToken)
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("fails to decode response, %v", err)
+		}
+		return resp.Paused
+	}
+
+	if w := serve(mux, http.MethodPost, "/api/jobs/pause", testApiToken); w.Code != http.StatusOK {
+		t.Fatalf("fails to pause, %d %s", w.Code, w.Body)
+	}
+	if !jobSwitch.Paused() || !paused() {
+		t.Fatal("expected jobs paused")
+	}
+
+	if w := serve(mux, http.MethodPost, "/api/jobs/resume", testApiToken); w.Code != http.StatusOK {
+		t.Fatalf("fails to resume, %d %s", w.Code, w.Body)
+	}
+	if jobSwitch.Paused() || paused() {
+		t.Fatal("expected jobs resumed")
+	}
+}
+
+func TestAdminHandler_HistoryLimit(t *testing.T) {
+	store := job.NewMemoryRecordStore()
+	for _, day := range []string{"2025-01-01", "2025-01-02", "2025-01-03"} {
+		if err := store.Save(&job.Record{Day: day}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mux, _ := newTestMux(t, store)
+
+	tests := []struct {
+		query string
+		code  int
+		count int
+	}{
+		{query: "", code: http.StatusOK, count: 3},
+		{query: "?limit=2", code: http.StatusOK, count: 2},
+		{query: "?limit=100", code: http.StatusOK, count: 3},
+		{query: "?limit=0", code: http.StatusBadRequest},
+		{query: "?limit=-1", code: http.StatusBadRequest},
+		{query: "?limit=abc", code: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		w := serve(mux, http.MethodGet, "/api/jobs/draw-lottery/history"+tt.query, testApiToken)
+		if w.Code != tt.code {
+			t.Fatalf("%q: expected %d, got %d", tt.query, tt.code, w.Code)
+		}
+		if tt.code != http.StatusOK {
+			continue
+		}
+		var records []*job.Record
+		if err := json.NewDecoder(w.Body).Decode(&records); err != nil || len(records) != tt.count {
+			t.Fatalf("%q: expected %d records, got %d, %v", tt.query, tt.count, len(records), err)
+		}
+	}
+}
+
+func TestAdminHandler_DrawNowConflict(t *testing.T) {
+	store := &blockingStore{RecordStore: job.NewMemoryRecordStore(), started: make(chan struct{}), release: make(chan struct{})}
+	mux, _ := newTestMux(t, store)
+
+	first := make(chan *httptest.ResponseRecorder)
+	go func() {
+		first <- serve(mux, http.MethodPost, "/api/jobs/draw-lottery/draw", testApiToken)
+	}()
+	<-store.started
+
+	// 开奖进行中时再次触发返回409
+	if w := serve(mux, http.MethodPost, "/api/jobs/draw-lottery/draw", testApiToken); w.Code != http.StatusConflict {
+		t.Fatalf("expected 409 while drawing, got %d", w.Code)
+	}
+
+	close(store.release)
+	if w := <-first; w.Code != http.StatusOK {
+		t.Fatalf("expected first draw to finish, got %d %s", w.Code, w.Body)
+	}
+}
@@ -1,22 +1,79 @@
 package server
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"github.com/robfig/cron/v3"
+	"lottery-go/internal/base/lifecycle"
 	"lottery-go/internal/base/logx"
 )
 
-func NewApp(cron *cron.Cron) *App {
-	return &App{cron: cron}
+// 取消运行中的任务后，等待其退出的最长时间
+const cancelWaitTimeout = 5 * time.Second
+
+// NewApp httpServer 在构造时已注册到 lc，这里仅用于让 wire 创建它
+func NewApp(lc *lifecycle.Lifecycle, cron *cron.Cron, httpServer *HttpServer) *App {
+	app := &App{lc: lc, cron: cron}
+	lc.Append(lifecycle.Hook{Name: "cron", OnStart: app.startCron, OnStop: app.stopCron})
+	return app
 }
 
 type App struct {
+	lc   *lifecycle.Lifecycle
 	cron *cron.Cron
 }
 
-func (app *App) Run() {
+// Run 启动所有组件，收到 SIGINT/SIGTERM 后按相反顺序停止
+func (app *App) Run() error {
+	if err := app.lc.Start(app.lc.Context()); err != nil {
+		return err
+	}
+	logx.Info("app started")
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(quit)
+
+	sig := <-quit
+	logx.Info("app stopping.", "signal", sig.String(), "timeout", cfg.ShutdownTimeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	err := app.lc.Stop(ctx)
+	app.lc.Cancel()
+
+	logx.Info("app stopped")
+	return err
+}
+
+func (app *App) startCron(context.Context) error {
 	// 启动定时任务
 	app.cron.Start()
+	return nil
+}
 
-	logx.Info("app started")
-	select {} // 阻塞主程序退出
+// stopCron 停止调度新任务，并等待运行中的任务完成；超时后取消任务的 context
+func (app *App) stopCron(ctx context.Context) error {
+	done := app.cron.Stop().Done()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+	}
+
+	logx.Warn("running jobs not finished in time, cancel them.")
+	app.lc.Cancel()
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(cancelWaitTimeout):
+		return ctx.Err()
+	}
 }
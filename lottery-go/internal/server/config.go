@@ -0,0 +1,44 @@
+package server
+
+import (
+	"github.com/spf13/viper"
+	"lottery-go/internal/config"
+	"time"
+)
+
+func init() {
+	config.Register("server", &ConfigLoader{})
+}
+
+var cfg *Cfg
+
+// =========== config info ===========
+
+type Cfg struct {
+	ShutdownTimeout time.Duration // 停止时等待运行中任务完成的最长时间，超时后取消任务
+	SelfTest        bool          // 启动时检查RPC节点、链ID、合约和账户，不通过时拒绝启动
+	Http            HttpCfg
+}
+
+// HttpCfg 管理接口配置
+type HttpCfg struct {
+	Addr     string // 监听地址，为空时不启动
+	ApiToken string // 手动开奖、暂停任务等操作接口的令牌，为空时禁止这些操作
+}
+
+// ========== ConfigLoader ==========
+
+type ConfigLoader struct{}
+
+// Load load server config info
+func (loader *ConfigLoader) Load(conf *viper.Viper) error {
+	conf.SetDefault("shutdownTimeout", "30s")
+	conf.SetDefault("selfTest", true)
+	conf.SetDefault("http.addr", ":8080")
+
+	if err := conf.Unmarshal(&cfg); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -5,9 +5,9 @@ import (
 	"lottery-go/internal/job"
 )
 
-func NewJob(registryJobs job.RegistryJobs) (*cron.Cron, error) {
+func NewJob(registryJobs job.RegistryJobs, jobSwitch *job.Switch) (*cron.Cron, error) {
 	c := cron.New(
-		cron.WithChain(job.Recovery),
+		cron.WithChain(job.Recovery, jobSwitch.SkipIfPaused),
 	)
 
 	if err := registryJobs(c); err != nil {
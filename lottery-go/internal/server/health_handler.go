@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"lottery-go/internal/application"
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/base/lifecycle"
+	"lottery-go/internal/base/logx"
+	"lottery-go/internal/pkg/alert"
+	"lottery-go/internal/pkg/health"
+)
+
+const (
+	// 单项检查的超时时间
+	checkTimeout = 10 * time.Second
+
+	// 发送报警的超时时间
+	alertTimeout = 30 * time.Second
+
+	// 任务下次执行时间落后当前时间超过该值，视为调度器停止
+	schedulerMaxDelay = time.Minute
+
+	// 就绪检查报警的任务名，报警原因为检查项名称
+	readinessAlertJob = "readiness"
+)
+
+// HealthHandler 存活、就绪检查接口，就绪检查同时作为启动自检
+type HealthHandler struct {
+	lc              *lifecycle.Lifecycle
+	cron            *cron.Cron
+	alertManager    *alert.Manager
+	dailyLotteryApp *application.DailyLotteryApplication
+}
+
+func NewHealthHandler(lc *lifecycle.Lifecycle, cron *cron.Cron, dailyLotteryApp *application.DailyLotteryApplication,
+	alertManager *alert.Manager) *HealthHandler {
+	h := &HealthHandler{lc: lc, cron: cron, alertManager: alertManager, dailyLotteryApp: dailyLotteryApp}
+
+	// 作为启动检查先于所有组件执行，自检不通过时不启动任何组件（包括重发交易日志中的交易）
+	if cfg.SelfTest {
+		lc.AppendCheck(lifecycle.Hook{Name: "selfTest", OnStart: h.selfTest})
+	}
+	return h
+}
+
+func (h *HealthHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /healthz", h.healthz)
+	mux.HandleFunc("GET /readyz", h.readyz)
+}
+
+// healthz 存活检查：进程未退出且调度器正常运行
+func (h *HealthHandler) healthz(w http.ResponseWriter, r *http.Request) {
+	report := health.Run(r.Context(), checkTimeout, []health.Check{
+		{Name: "lifecycle", Fn: h.checkLifecycle},
+		{Name: "scheduler", Fn: h.checkScheduler},
+	})
+	writeReport(w, report)
+}
+
+// readyz 就绪检查：开奖依赖的RPC节点、合约、账户均可用，不通过时报警
+func (h *HealthHandler) readyz(w http.ResponseWriter, r *http.Request) {
+	report := health.Run(r.Context(), checkTimeout, h.dailyLotteryApp.ReadinessChecks())
+	h.alertReport(report, alert.Warning)
+	writeReport(w, report)
+}
+
+// selfTest 启动自检，配置错误时拒绝启动
+func (h *HealthHandler) selfTest(ctx context.Context) error {
+	report := health.Run(ctx, checkTimeout, h.dailyLotteryApp.ReadinessChecks())
+	for _, result := range report.Checks {
+		if result.Ok {
+			logx.Info("self test passed.", "name", result.Name)
+		} else {
+			logx.Error("self test failed.", "name", result.Name, "err", result.Error)
+		}
+	}
+	if !report.Ok {
+		h.alertReport(report, alert.Critical)
+		return errorx.Wrap("self test failed", report.Err())
+	}
+	return nil
+}
+
+// alertReport 每个不通过的检查项发送一条报警，相同检查项在去重窗口内只发送一次；全部通过时发送恢复通知
+func (h *HealthHandler) alertReport(report *health.Report, severity alert.Severity) {
+	// 请求结束或应用停止时仍需发出报警，不使用请求的 context
+	ctx, cancel := context.WithTimeout(context.Background(), alertTimeout)
+	defer cancel()
+
+	if report.Ok {
+		if err := h.alertManager.Resolve(ctx, readinessAlertJob, 0, "readiness checks passed"); err != nil {
+			logx.ErrorF("failed to resolve alarm. %v", err)
+		}
+		return
+	}
+
+	for _, result := range report.Checks {
+		if result.Ok {
+			continue
+		}
+		msg := &alert.Message{Severity: severity, Title: "readiness check failed", Job: readinessAlertJob,
+			Reason: result.Name, Error: result.Error}
+		if err := h.alertManager.Notify(ctx, msg); err != nil {
+			logx.ErrorF("failed to trigger alarm. %v", err)
+		}
+	}
+}
+
+func (h *HealthHandler) checkLifecycle(context.Context) error {
+	if err := h.lc.Context().Err(); err != nil {
+		return errorx.Wrap("app is stopping", err)
+	}
+	return nil
+}
+
+// checkScheduler 调度器协程退出或阻塞时，获取任务列表会超时，或任务的下次执行时间已过去很久
+func (h *HealthHandler) checkScheduler(ctx context.Context) error {
+	entries := make(chan []cron.Entry, 1)
+	go func() {
+		entries <- h.cron.Entries()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return errorx.New("scheduler not responding")
+	case list := <-entries:
+		now := time.Now()
+		for _, entry := range list {
+			if !entry.Next.IsZero() && now.Sub(entry.Next) > schedulerMaxDelay {
+				return errorx.New("scheduler is late", "entry", entry.ID, "next", entry.Next.Format(time.DateTime))
+			}
+		}
+		return nil
+	}
+}
+
+func writeReport(w http.ResponseWriter, report *health.Report) {
+	status := http.StatusOK
+	if !report.Ok {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, report)
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"lottery-go/internal/base/errorx"
+	"lottery-go/internal/base/lifecycle"
+	"lottery-go/internal/base/logx"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// HttpServer 内嵌的管理接口服务
+type HttpServer struct {
+	server *http.Server
+}
+
+func NewHttpServer(lc *lifecycle.Lifecycle, adminHandler *AdminHandler, healthHandler *HealthHandler) *HttpServer {
+	mux := http.NewServeMux()
+	adminHandler.Register(mux)
+	healthHandler.Register(mux)
+	mux.Handle("GET /metrics", promhttp.Handler())
+
+	s := &HttpServer{server: &http.Server{
+		Addr:              cfg.Http.Addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}}
+
+	if cfg.Http.Addr != "" {
+		lc.Append(lifecycle.Hook{Name: "http", OnStart: s.start, OnStop: s.stop})
+	}
+	return s
+}
+
+func (s *HttpServer) start(context.Context) error {
+	// 先监听端口，端口被占用时启动失败
+	listener, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		return errorx.Wrap("failed to listen", err, "addr", s.server.Addr)
+	}
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logx.ErrorF("http server stopped. %v", err)
+		}
+	}()
+	logx.Info("http server listening.", "addr", s.server.Addr)
+	return nil
+}
+
+func (s *HttpServer) stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+// ========== helpers ==========
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		logx.ErrorF("failed to write response. %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, &errorResponse{Error: err.Error()})
+}
+
+// requireToken 校验请求头 Authorization: Bearer <token>，未配置令牌时拒绝所有请求
+func requireToken(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if cfg.Http.ApiToken == "" {
+			writeError(w, http.StatusForbidden, errors.New("api token not configured"))
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(cfg.Http.ApiToken)) != 1 {
+			writeError(w, http.StatusUnauthorized, errors.New("invalid api token"))
+			return
+		}
+
+		next(w, r)
+	}
+}
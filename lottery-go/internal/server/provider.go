@@ -1,5 +1,8 @@
 package server
 
-import "github.com/google/wire"
+import (
+	"github.com/google/wire"
+	"lottery-go/internal/base/lifecycle"
+)
 
-var ProviderSet = wire.NewSet(NewJob, NewApp)
+var ProviderSet = wire.NewSet(lifecycle.New, NewJob, NewAdminHandler, NewHealthHandler, NewHttpServer, NewApp)